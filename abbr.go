@@ -0,0 +1,102 @@
+package html2text
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AbbrMode specifies how expansions of abbreviations are written
+type AbbrMode int
+
+const (
+	// AbbrNone writes abbreviations as they are (the default)
+	AbbrNone AbbrMode = iota
+	// AbbrInline writes the expansion after the first occurrence, e.g. "HTML (HyperText Markup Language)"
+	AbbrInline
+	// AbbrFootnotes marks the first occurrence by a reference, e.g. "HTML[1]", and lists
+	// the expansions at the end of the document
+	AbbrFootnotes
+)
+
+// WithAbbreviations instructs the converter to expand <abbr> and <acronym>
+// using their title attribute on the first occurrence
+func WithAbbreviations(mode AbbrMode) Option {
+	return func(o *options) {
+		o.abbrMode = mode
+	}
+}
+
+// WithTitleAttributes instructs the converter to write title attributes of other elements
+// the same way as expansions of abbreviations (inline unless AbbrFootnotes is used)
+func WithTitleAttributes() Option {
+	return func(o *options) {
+		o.titleAttributes = true
+	}
+}
+
+// titleElement expands the title attribute of abbreviations
+// and optionally of other elements once their text is written,
+// void and self-closing elements have no text so their title is skipped
+func (c *converter) titleElement(e *element) {
+	if !c.isOpen(e) {
+		return
+	}
+
+	isAbbr := e.tagName == "abbr" || e.tagName == "acronym"
+	if isAbbr && c.opts.abbrMode == AbbrNone || !isAbbr && !c.opts.titleAttributes {
+		return
+	}
+
+	title := strings.Join(strings.Fields(e.attr("title")), " ")
+	if title == "" {
+		return
+	}
+
+	var m *marker
+	// called after the marker is closed
+	e.onClose = append(e.onClose, func() {
+		if !m.written || c.hidden() {
+			return
+		}
+		key := strings.TrimSpace(string(c.outBuf.Bytes()[m.start:]))
+		if isAbbr {
+			if c.abbrSeen[key] {
+				return
+			}
+			if c.abbrSeen == nil {
+				c.abbrSeen = map[string]bool{}
+			}
+			c.abbrSeen[key] = true
+		}
+
+		if c.opts.abbrMode == AbbrFootnotes {
+			c.footnotes = append(c.footnotes, title)
+			c.writeSuffix("[" + strconv.Itoa(len(c.footnotes)) + "]")
+		} else {
+			c.writeSuffix(" (" + title + ")")
+		}
+	})
+	m = c.markElement(e, "", "")
+}
+
+// writeSuffix writes the text right after the text written so far,
+// before the whitespace and line breaks requested after it.
+// The text is not part of the text of open links.
+func (c *converter) writeSuffix(text string) {
+	space, lbr, links := c.pendingSpace, c.pendingLbr, c.openLinks
+	c.pendingSpace, c.pendingLbr, c.openLinks = false, 0, nil
+	c.writeText(text)
+	c.pendingSpace, c.pendingLbr, c.openLinks = space, lbr, links
+}
+
+// writeFootnotes lists collected footnotes at the end of the document
+func (c *converter) writeFootnotes() {
+	for i, note := range c.footnotes {
+		if i == 0 {
+			c.blockBreak(2)
+		} else {
+			c.blockBreak(1)
+		}
+		c.writeText("[" + strconv.Itoa(i+1) + "] " + note)
+	}
+}
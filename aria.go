@@ -0,0 +1,203 @@
+package html2text
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var anyTagRE = regexp.MustCompile(`<([a-zA-Z][\w:-]*)(?:[\s/][^>]*)?>`)
+
+// landmarkNames maps landmark roles to their announcement
+var landmarkNames = map[string]string{
+	"banner":        "Banner",
+	"complementary": "Complementary",
+	"contentinfo":   "Content info",
+	"form":          "Form",
+	"main":          "Main",
+	"navigation":    "Navigation",
+	"region":        "Region",
+	"search":        "Search",
+}
+
+// implicitRoles maps elements to their implicit ARIA roles, if any is relevant to the converter
+var implicitRoles = map[string]string{
+	"aside":   "complementary",
+	"footer":  "contentinfo",
+	"form":    "form",
+	"header":  "banner",
+	"main":    "main",
+	"nav":     "navigation",
+	"search":  "search",
+	"section": "region",
+}
+
+// nameFromContentTags and nameFromContentRoles list elements and roles whose accessible name
+// is computed from their content, so their aria-label replaces the text
+var nameFromContentTags = map[string]bool{
+	"a": true, "button": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"summary": true, "td": true, "th": true, "option": true, "img": true,
+}
+var nameFromContentRoles = map[string]bool{
+	"button": true, "cell": true, "checkbox": true, "columnheader": true, "gridcell": true,
+	"heading": true, "link": true, "menuitem": true, "menuitemcheckbox": true, "menuitemradio": true,
+	"option": true, "radio": true, "rowheader": true, "switch": true, "tab": true,
+	"tooltip": true, "treeitem": true,
+}
+
+// WithARIA instructs the converter to follow the accessibility tree instead of the markup:
+// aria-label and aria-labelledby replace the text of links, buttons, headings and similar,
+// role=heading with aria-level is a heading, role=list and role=listitem are lists,
+// landmarks are announced, e.g. "Navigation: ", and content with aria-hidden is omitted
+func WithARIA() Option {
+	return func(o *options) {
+		o.aria = true
+	}
+}
+
+// ariaRole returns the explicit role of the element or its implicit landmark role
+func (c *converter) ariaRole(e *element) string {
+	if roles := strings.Fields(strings.ToLower(e.attr("role"))); len(roles) > 0 {
+		return roles[0]
+	}
+
+	role := implicitRoles[e.tagName]
+	switch role {
+	case "banner", "contentinfo":
+		// header and footer are landmarks only outside of sectioning content
+		for _, open := range c.stack {
+			switch open.tagName {
+			case "article", "aside", "main", "nav", "section":
+				return ""
+			}
+		}
+	case "form", "region":
+		// only if they have a name
+		if e.attr("aria-label") == "" && e.attr("aria-labelledby") == "" {
+			return ""
+		}
+	}
+	return role
+}
+
+// ariaElement applies the role of the opened element, html is the whole document
+func (c *converter) ariaElement(e *element, html string) {
+	if e.attr("aria-hidden") == "true" || e.hasAttr("hidden") {
+		c.hideElement(e)
+		return
+	}
+
+	role := c.ariaRole(e)
+	switch role {
+	case "heading":
+		level, err := strconv.Atoi(strings.TrimSpace(e.attr("aria-level")))
+		if err != nil || level < 1 || level > 6 {
+			level = 2
+		}
+		c.openHeading(level)
+		if c.isOpen(e) {
+			e.onClose = append(e.onClose, c.closeHeading)
+		}
+
+	case "list":
+		c.blockBreak(1)
+		if c.isOpen(e) {
+			e.onClose = append(e.onClose, c.endLine)
+		}
+
+	case "listitem":
+		c.blockBreak(1)
+		// the prefix is written with the first text, after line breaks of the element itself
+		c.pendingPrefix = c.opts.listPrefix
+		if c.isOpen(e) {
+			e.onClose = append(e.onClose, func() {
+				c.blockBreak(1)
+			})
+		}
+
+	default:
+		announcement, ok := landmarkNames[role]
+		if !ok || !c.isOpen(e) {
+			return
+		}
+		if name := c.accessibleName(e, html); name != "" {
+			announcement += " (" + name + ")"
+		}
+
+		// written with the first text of the landmark
+		c.markElement(e, announcement+": ", "")
+	}
+}
+
+// ariaLabel replaces the text of the opened element by its accessible name
+// taken from aria-labelledby or aria-label, html is the whole document
+func (c *converter) ariaLabel(e *element, html string) {
+	role := c.ariaRole(e)
+	if !nameFromContentRoles[role] && !(role == "" && nameFromContentTags[e.tagName]) {
+		return
+	}
+
+	name := c.accessibleName(e, html)
+	if name == "" {
+		return
+	}
+	c.writeText(name)
+	c.hideElement(e)
+}
+
+// accessibleName returns the text of the elements referenced by aria-labelledby or aria-label
+func (c *converter) accessibleName(e *element, html string) string {
+	var names []string
+	for _, id := range strings.Fields(e.attr("aria-labelledby")) {
+		if text := c.textByID(html, id); text != "" {
+			names = append(names, text)
+		}
+	}
+	if len(names) == 0 {
+		names = append(names, e.attr("aria-label"))
+	}
+	return strings.Join(strings.Fields(strings.Join(names, " ")), " ")
+}
+
+// idElement is an element referenced by aria-labelledby
+type idElement struct {
+	tagName string
+	tag     string
+	rest    string // the html following the tag
+	text    string
+	done    bool // text has been computed
+}
+
+// textByID returns the text of the element with the id in the html, the elements
+// with an id are indexed on the first call so each reference does not rescan the html
+func (c *converter) textByID(html, id string) string {
+	if c.ids == nil {
+		c.ids = map[string]*idElement{}
+		for _, m := range anyTagRE.FindAllStringSubmatchIndex(html, -1) {
+			tag := html[m[0]+1 : m[1]-1]
+			if indexFold(tag, "id") < 0 {
+				continue
+			}
+			if id := parseAttrs(tag)["id"]; id != "" && c.ids[id] == nil {
+				c.ids[id] = &idElement{tagName: strings.ToLower(html[m[2]:m[3]]), tag: tag, rest: html[m[1]:]}
+			}
+		}
+	}
+
+	e := c.ids[id]
+	if e == nil {
+		return ""
+	}
+	if !e.done {
+		e.done = true
+		if !voidTags[e.tagName] && !strings.HasSuffix(e.tag, "/") {
+			rest := e.rest
+			if end := indexEndTag(rest, e.tagName); end >= 0 {
+				rest = rest[:end]
+			}
+			e.text = strings.Join(strings.Fields(HTML2Text(rest)), " ")
+		}
+		e.rest = ""
+	}
+	return e.text
+}
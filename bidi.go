@@ -0,0 +1,68 @@
+package html2text
+
+import "strings"
+
+// Unicode directional formatting characters
+const (
+	bidiLRE = "\u202A" // left-to-right embedding
+	bidiRLE = "\u202B" // right-to-left embedding
+	bidiPDF = "\u202C" // pop directional formatting
+	bidiLRO = "\u202D" // left-to-right override
+	bidiRLO = "\u202E" // right-to-left override
+	bidiLRI = "\u2066" // left-to-right isolate
+	bidiRLI = "\u2067" // right-to-left isolate
+	bidiFSI = "\u2068" // first strong isolate
+	bidiPDI = "\u2069" // pop directional isolate
+)
+
+// bidiControlsReplacer removes embeddings, overrides and isolates which can be used
+// to make text appear differently from its logical order (CVE-2021-42574, Trojan Source)
+var bidiControlsReplacer = strings.NewReplacer(
+	bidiLRE, "", bidiRLE, "", bidiPDF, "", bidiLRO, "", bidiRLO, "",
+	bidiLRI, "", bidiRLI, "", bidiFSI, "", bidiPDI, "",
+)
+
+// WithBidiIsolates instructs the converter to preserve the text direction of elements
+// with a dir attribute and of <bdi> by wrapping them into Unicode directional isolates
+// (LRI, RLI or FSI ... PDI). <bdo> is written as a directional override inside an isolate.
+func WithBidiIsolates() Option {
+	return func(o *options) {
+		o.bidiIsolates = true
+	}
+}
+
+// WithBidiSanitizing instructs the converter to remove directional embedding, override
+// and isolate characters from the input, including those encoded as entities.
+// Isolates added by WithBidiIsolates are kept.
+func WithBidiSanitizing() Option {
+	return func(o *options) {
+		o.bidiSanitizing = true
+	}
+}
+
+// bidiElement wraps elements with a direction into isolates
+func (c *converter) bidiElement(e *element) {
+	if !c.opts.bidiIsolates {
+		return
+	}
+
+	dir := strings.ToLower(strings.TrimSpace(e.attr("dir")))
+
+	var open, close string
+	switch {
+	case e.tagName == "bdo" && dir == "rtl":
+		open, close = bidiRLI+bidiRLO, bidiPDF+bidiPDI
+	case e.tagName == "bdo" && dir == "ltr":
+		open, close = bidiLRI+bidiLRO, bidiPDF+bidiPDI
+	case dir == "rtl":
+		open, close = bidiRLI, bidiPDI
+	case dir == "ltr":
+		open, close = bidiLRI, bidiPDI
+	case dir == "auto" || e.tagName == "bdi":
+		open, close = bidiFSI, bidiPDI
+	default:
+		return
+	}
+
+	c.markElement(e, open, close)
+}
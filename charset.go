@@ -0,0 +1,305 @@
+package html2text
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// metaCharsetRE matches both <meta charset="..."> and
+// <meta http-equiv="Content-Type" content="text/html; charset=...">
+var metaCharsetRE = regexp.MustCompile(`(?is)<meta\s[^>]*?charset\s*=\s*["']?\s*([a-z0-9_:.\-]+)`)
+
+// charsetPrescanLen is the number of bytes searched for a <meta> charset declaration
+const charsetPrescanLen = 1024
+
+// CharsetDecoder converts input in the named charset into UTF-8. It is called
+// with lower-cased charset names the package doesn't support itself and
+// returns false if it doesn't support the charset either.
+type CharsetDecoder func(charset string, input []byte) (string, bool)
+
+// WithCharset instructs HTML2TextBytes to decode the input using the
+// specified charset (e.g. from a Content-Type header) instead of
+// the one declared by <meta> tags. A byte order mark still takes precedence.
+func WithCharset(charset string) Option {
+	return func(o *options) {
+		o.charset = charset
+	}
+}
+
+// WithCharsetDecoder instructs HTML2TextBytes to use the decoder
+// for charsets which are not supported by this package (e.g. Shift_JIS)
+func WithCharsetDecoder(dec CharsetDecoder) Option {
+	return func(o *options) {
+		o.charsetDecoder = dec
+	}
+}
+
+// charsetLabels maps charset labels to their canonical names as defined by
+// the WHATWG Encoding Standard https://encoding.spec.whatwg.org/#names-and-labels
+var charsetLabels = map[string]string{}
+
+func init() {
+	for name, labels := range map[string][]string{
+		"utf-8":    {"unicode-1-1-utf-8", "unicode11utf8", "unicode20utf8", "utf-8", "utf8", "x-unicode20utf8"},
+		"utf-16le": {"csunicode", "iso-10646-ucs-2", "ucs-2", "unicode", "unicodefeff", "utf-16", "utf-16le"},
+		"utf-16be": {"unicodefffe", "utf-16be"},
+		"windows-1252": {"ansi_x3.4-1968", "ascii", "cp1252", "cp819", "csisolatin1", "ibm819", "iso-8859-1",
+			"iso-ir-100", "iso8859-1", "iso88591", "iso_8859-1", "iso_8859-1:1987", "l1", "latin1", "us-ascii",
+			"windows-1252", "x-cp1252"},
+		"iso-8859-15":  {"csisolatin9", "iso-8859-15", "iso8859-15", "iso885915", "iso_8859-15", "l9"},
+		"windows-1251": {"cp1251", "windows-1251", "x-cp1251"},
+		"koi8-r":       {"cskoi8r", "koi", "koi8", "koi8-r", "koi8_r"},
+		"koi8-u":       {"koi8-ru", "koi8-u"},
+		"iso-8859-5": {"csisolatincyrillic", "cyrillic", "iso-8859-5", "iso-ir-144", "iso8859-5", "iso88595",
+			"iso_8859-5", "iso_8859-5:1988"},
+		"ibm866": {"866", "cp866", "csibm866", "ibm866"},
+	} {
+		for _, label := range labels {
+			charsetLabels[label] = name
+		}
+	}
+
+	for i := range windows1252Table {
+		windows1252Table[i] = rune(0x80 + i)
+		if i < len(windows1252) && windows1252[i] != 0 {
+			windows1252Table[i] = windows1252[i]
+		}
+	}
+}
+
+// singleByteCharsets maps canonical charset names to the upper half of their
+// code page, the lower half is always ASCII
+var singleByteCharsets = map[string]*[128]rune{
+	"windows-1252": &windows1252Table,
+	"iso-8859-15":  &iso885915,
+	"windows-1251": &windows1251,
+	"koi8-r":       &koi8R,
+	"koi8-u":       &koi8U,
+	"iso-8859-5":   &iso88595,
+	"ibm866":       &ibm866,
+}
+
+// normalizeCharset returns the canonical name of the charset label
+// or the lower-cased label if it is not known
+func normalizeCharset(label string) string {
+	label = strings.ToLower(strings.TrimSpace(label))
+	if name, ok := charsetLabels[label]; ok {
+		return name
+	}
+	return label
+}
+
+// detectCharset determines the charset of html from its byte order mark,
+// the hint or <meta> tags, in that order. It returns the canonical charset
+// name and the length of the byte order mark.
+func detectCharset(html []byte, hint string) (string, int) {
+	switch {
+	case bytes.HasPrefix(html, []byte{0xEF, 0xBB, 0xBF}):
+		return "utf-8", 3
+	case bytes.HasPrefix(html, []byte{0xFE, 0xFF}):
+		return "utf-16be", 2
+	case bytes.HasPrefix(html, []byte{0xFF, 0xFE}):
+		return "utf-16le", 2
+	}
+
+	if hint != "" {
+		return normalizeCharset(hint), 0
+	}
+
+	prescan := html
+	if len(prescan) > charsetPrescanLen {
+		prescan = prescan[:charsetPrescanLen]
+	}
+	if m := metaCharsetRE.FindSubmatch(prescan); m != nil {
+		charset := normalizeCharset(string(m[1]))
+		if charset == "utf-16le" || charset == "utf-16be" {
+			// a document which can be prescanned for ASCII is not UTF-16
+			charset = "utf-8"
+		}
+		return charset, 0
+	}
+
+	return "utf-8", 0
+}
+
+// decodeCharset converts html into UTF-8 according to detectCharset.
+// Input in charsets that cannot be decoded is returned as it is.
+func decodeCharset(html []byte, opts *options) string {
+	charset, bomLen := detectCharset(html, opts.charset)
+	html = html[bomLen:]
+
+	switch charset {
+	case "utf-8":
+		return string(html)
+
+	case "utf-16le", "utf-16be":
+		units := make([]uint16, len(html)/2)
+		for i := range units {
+			if charset == "utf-16le" {
+				units[i] = uint16(html[2*i]) | uint16(html[2*i+1])<<8
+			} else {
+				units[i] = uint16(html[2*i])<<8 | uint16(html[2*i+1])
+			}
+		}
+		return string(utf16.Decode(units))
+	}
+
+	if table, ok := singleByteCharsets[charset]; ok {
+		outBuf := bytes.NewBuffer(make([]byte, 0, len(html)+len(html)/2))
+		for _, b := range html {
+			if b < utf8.RuneSelf {
+				outBuf.WriteByte(b)
+			} else {
+				outBuf.WriteRune(table[b-0x80])
+			}
+		}
+		return outBuf.String()
+	}
+
+	if opts.charsetDecoder != nil {
+		if text, ok := opts.charsetDecoder(charset, html); ok {
+			return text
+		}
+	}
+
+	return string(html)
+}
+
+// HTML2TextBytes converts html in any of the supported charsets into a text form.
+// The charset is detected from a byte order mark, the WithCharset option or
+// <meta> tags. UTF-8, UTF-16 and the common Western and Cyrillic single-byte
+// charsets are supported, others can be added using WithCharsetDecoder.
+func HTML2TextBytes(html []byte, reqOpts ...Option) string {
+	opts := newOptions()
+	for _, opt := range reqOpts {
+		opt(opts)
+	}
+
+	return HTML2TextWithOptions(decodeCharset(html, opts), reqOpts...)
+}
+
+// windows1252Table is the upper half of Windows-1252 built from windows1252 in init
+var windows1252Table [128]rune
+
+// iso885915 is the upper half of ISO-8859-15
+var iso885915 = [128]rune{
+	0x0080, 0x0081, 0x0082, 0x0083, 0x0084, 0x0085, 0x0086, 0x0087,
+	0x0088, 0x0089, 0x008A, 0x008B, 0x008C, 0x008D, 0x008E, 0x008F,
+	0x0090, 0x0091, 0x0092, 0x0093, 0x0094, 0x0095, 0x0096, 0x0097,
+	0x0098, 0x0099, 0x009A, 0x009B, 0x009C, 0x009D, 0x009E, 0x009F,
+	0x00A0, 0x00A1, 0x00A2, 0x00A3, 0x20AC, 0x00A5, 0x0160, 0x00A7,
+	0x0161, 0x00A9, 0x00AA, 0x00AB, 0x00AC, 0x00AD, 0x00AE, 0x00AF,
+	0x00B0, 0x00B1, 0x00B2, 0x00B3, 0x017D, 0x00B5, 0x00B6, 0x00B7,
+	0x017E, 0x00B9, 0x00BA, 0x00BB, 0x0152, 0x0153, 0x0178, 0x00BF,
+	0x00C0, 0x00C1, 0x00C2, 0x00C3, 0x00C4, 0x00C5, 0x00C6, 0x00C7,
+	0x00C8, 0x00C9, 0x00CA, 0x00CB, 0x00CC, 0x00CD, 0x00CE, 0x00CF,
+	0x00D0, 0x00D1, 0x00D2, 0x00D3, 0x00D4, 0x00D5, 0x00D6, 0x00D7,
+	0x00D8, 0x00D9, 0x00DA, 0x00DB, 0x00DC, 0x00DD, 0x00DE, 0x00DF,
+	0x00E0, 0x00E1, 0x00E2, 0x00E3, 0x00E4, 0x00E5, 0x00E6, 0x00E7,
+	0x00E8, 0x00E9, 0x00EA, 0x00EB, 0x00EC, 0x00ED, 0x00EE, 0x00EF,
+	0x00F0, 0x00F1, 0x00F2, 0x00F3, 0x00F4, 0x00F5, 0x00F6, 0x00F7,
+	0x00F8, 0x00F9, 0x00FA, 0x00FB, 0x00FC, 0x00FD, 0x00FE, 0x00FF,
+}
+
+// windows1251 is the upper half of Windows-1251
+var windows1251 = [128]rune{
+	0x0402, 0x0403, 0x201A, 0x0453, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x20AC, 0x2030, 0x0409, 0x2039, 0x040A, 0x040C, 0x040B, 0x040F,
+	0x0452, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0x0098, 0x2122, 0x0459, 0x203A, 0x045A, 0x045C, 0x045B, 0x045F,
+	0x00A0, 0x040E, 0x045E, 0x0408, 0x00A4, 0x0490, 0x00A6, 0x00A7,
+	0x0401, 0x00A9, 0x0404, 0x00AB, 0x00AC, 0x00AD, 0x00AE, 0x0407,
+	0x00B0, 0x00B1, 0x0406, 0x0456, 0x0491, 0x00B5, 0x00B6, 0x00B7,
+	0x0451, 0x2116, 0x0454, 0x00BB, 0x0458, 0x0405, 0x0455, 0x0457,
+	0x0410, 0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416, 0x0417,
+	0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E, 0x041F,
+	0x0420, 0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427,
+	0x0428, 0x0429, 0x042A, 0x042B, 0x042C, 0x042D, 0x042E, 0x042F,
+	0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437,
+	0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E, 0x043F,
+	0x0440, 0x0441, 0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447,
+	0x0448, 0x0449, 0x044A, 0x044B, 0x044C, 0x044D, 0x044E, 0x044F,
+}
+
+// koi8R is the upper half of KOI8-R
+var koi8R = [128]rune{
+	0x2500, 0x2502, 0x250C, 0x2510, 0x2514, 0x2518, 0x251C, 0x2524,
+	0x252C, 0x2534, 0x253C, 0x2580, 0x2584, 0x2588, 0x258C, 0x2590,
+	0x2591, 0x2592, 0x2593, 0x2320, 0x25A0, 0x2219, 0x221A, 0x2248,
+	0x2264, 0x2265, 0x00A0, 0x2321, 0x00B0, 0x00B2, 0x00B7, 0x00F7,
+	0x2550, 0x2551, 0x2552, 0x0451, 0x2553, 0x2554, 0x2555, 0x2556,
+	0x2557, 0x2558, 0x2559, 0x255A, 0x255B, 0x255C, 0x255D, 0x255E,
+	0x255F, 0x2560, 0x2561, 0x0401, 0x2562, 0x2563, 0x2564, 0x2565,
+	0x2566, 0x2567, 0x2568, 0x2569, 0x256A, 0x256B, 0x256C, 0x00A9,
+	0x044E, 0x0430, 0x0431, 0x0446, 0x0434, 0x0435, 0x0444, 0x0433,
+	0x0445, 0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E,
+	0x043F, 0x044F, 0x0440, 0x0441, 0x0442, 0x0443, 0x0436, 0x0432,
+	0x044C, 0x044B, 0x0437, 0x0448, 0x044D, 0x0449, 0x0447, 0x044A,
+	0x042E, 0x0410, 0x0411, 0x0426, 0x0414, 0x0415, 0x0424, 0x0413,
+	0x0425, 0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E,
+	0x041F, 0x042F, 0x0420, 0x0421, 0x0422, 0x0423, 0x0416, 0x0412,
+	0x042C, 0x042B, 0x0417, 0x0428, 0x042D, 0x0429, 0x0427, 0x042A,
+}
+
+// koi8U is the upper half of KOI8-U as defined by the WHATWG Encoding Standard (KOI8-RU)
+var koi8U = [128]rune{
+	0x2500, 0x2502, 0x250C, 0x2510, 0x2514, 0x2518, 0x251C, 0x2524,
+	0x252C, 0x2534, 0x253C, 0x2580, 0x2584, 0x2588, 0x258C, 0x2590,
+	0x2591, 0x2592, 0x2593, 0x2320, 0x25A0, 0x2219, 0x221A, 0x2248,
+	0x2264, 0x2265, 0x00A0, 0x2321, 0x00B0, 0x00B2, 0x00B7, 0x00F7,
+	0x2550, 0x2551, 0x2552, 0x0451, 0x0454, 0x2554, 0x0456, 0x0457,
+	0x2557, 0x2558, 0x2559, 0x255A, 0x255B, 0x0491, 0x045E, 0x255E,
+	0x255F, 0x2560, 0x2561, 0x0401, 0x0404, 0x2563, 0x0406, 0x0407,
+	0x2566, 0x2567, 0x2568, 0x2569, 0x256A, 0x0490, 0x040E, 0x00A9,
+	0x044E, 0x0430, 0x0431, 0x0446, 0x0434, 0x0435, 0x0444, 0x0433,
+	0x0445, 0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E,
+	0x043F, 0x044F, 0x0440, 0x0441, 0x0442, 0x0443, 0x0436, 0x0432,
+	0x044C, 0x044B, 0x0437, 0x0448, 0x044D, 0x0449, 0x0447, 0x044A,
+	0x042E, 0x0410, 0x0411, 0x0426, 0x0414, 0x0415, 0x0424, 0x0413,
+	0x0425, 0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E,
+	0x041F, 0x042F, 0x0420, 0x0421, 0x0422, 0x0423, 0x0416, 0x0412,
+	0x042C, 0x042B, 0x0417, 0x0428, 0x042D, 0x0429, 0x0427, 0x042A,
+}
+
+// iso88595 is the upper half of ISO-8859-5
+var iso88595 = [128]rune{
+	0x0080, 0x0081, 0x0082, 0x0083, 0x0084, 0x0085, 0x0086, 0x0087,
+	0x0088, 0x0089, 0x008A, 0x008B, 0x008C, 0x008D, 0x008E, 0x008F,
+	0x0090, 0x0091, 0x0092, 0x0093, 0x0094, 0x0095, 0x0096, 0x0097,
+	0x0098, 0x0099, 0x009A, 0x009B, 0x009C, 0x009D, 0x009E, 0x009F,
+	0x00A0, 0x0401, 0x0402, 0x0403, 0x0404, 0x0405, 0x0406, 0x0407,
+	0x0408, 0x0409, 0x040A, 0x040B, 0x040C, 0x00AD, 0x040E, 0x040F,
+	0x0410, 0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416, 0x0417,
+	0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E, 0x041F,
+	0x0420, 0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427,
+	0x0428, 0x0429, 0x042A, 0x042B, 0x042C, 0x042D, 0x042E, 0x042F,
+	0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437,
+	0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E, 0x043F,
+	0x0440, 0x0441, 0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447,
+	0x0448, 0x0449, 0x044A, 0x044B, 0x044C, 0x044D, 0x044E, 0x044F,
+	0x2116, 0x0451, 0x0452, 0x0453, 0x0454, 0x0455, 0x0456, 0x0457,
+	0x0458, 0x0459, 0x045A, 0x045B, 0x045C, 0x00A7, 0x045E, 0x045F,
+}
+
+// ibm866 is the upper half of IBM866
+var ibm866 = [128]rune{
+	0x0410, 0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416, 0x0417,
+	0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E, 0x041F,
+	0x0420, 0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427,
+	0x0428, 0x0429, 0x042A, 0x042B, 0x042C, 0x042D, 0x042E, 0x042F,
+	0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437,
+	0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E, 0x043F,
+	0x2591, 0x2592, 0x2593, 0x2502, 0x2524, 0x2561, 0x2562, 0x2556,
+	0x2555, 0x2563, 0x2551, 0x2557, 0x255D, 0x255C, 0x255B, 0x2510,
+	0x2514, 0x2534, 0x252C, 0x251C, 0x2500, 0x253C, 0x255E, 0x255F,
+	0x255A, 0x2554, 0x2569, 0x2566, 0x2560, 0x2550, 0x256C, 0x2567,
+	0x2568, 0x2564, 0x2565, 0x2559, 0x2558, 0x2552, 0x2553, 0x256B,
+	0x256A, 0x2518, 0x250C, 0x2588, 0x2584, 0x258C, 0x2590, 0x2580,
+	0x0440, 0x0441, 0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447,
+	0x0448, 0x0449, 0x044A, 0x044B, 0x044C, 0x044D, 0x044E, 0x044F,
+	0x0401, 0x0451, 0x0404, 0x0454, 0x0407, 0x0457, 0x040E, 0x045E,
+	0x00B0, 0x2219, 0x00B7, 0x221A, 0x2116, 0x00A4, 0x25A0, 0x00A0,
+}
@@ -0,0 +1,288 @@
+package html2text
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var cssCommentRE = regexp.MustCompile(`(?s)/\*.*?\*/`)
+var cssSelectorRE = regexp.MustCompile(`^([a-z][a-z0-9-]*|\*)?((?:[.#][\w-]+)*)$`)
+var cssSimpleSelectorRE = regexp.MustCompile(`[.#][\w-]+`)
+
+// WithCSS instructs the converter to honor inline style attributes and simple rules
+// from <style> blocks (tag, class and id selectors). The following properties are supported:
+//
+//	display: none hides the element, block, list-item, table-row and similar start a new line,
+//	         inline and inline-block keep block elements on the same line
+//	visibility: hidden and collapse hide the element
+//	text-transform: uppercase, lowercase and capitalize
+//	white-space: pre, pre-wrap and break-spaces preserve whitespace, pre-line preserves line breaks
+//
+// <pre> preserves whitespace in this mode, as in browsers.
+func WithCSS() Option {
+	return func(o *options) {
+		o.css = true
+	}
+}
+
+// cssRule is a rule with a simple selector, e.g. "p.note#main"
+type cssRule struct {
+	tagName     string
+	ids         []string
+	classes     []string
+	specificity int
+	order       int
+	decls       map[string]string
+}
+
+func (r *cssRule) matches(e *element) bool {
+	if r.tagName != "" && r.tagName != "*" && r.tagName != e.tagName {
+		return false
+	}
+	for _, id := range r.ids {
+		if e.attr("id") != id {
+			return false
+		}
+	}
+	classes := strings.Fields(e.attr("class"))
+	for _, class := range r.classes {
+		found := false
+		for _, c := range classes {
+			if c == class {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDeclarations parses CSS declarations like "display: none; color: red"
+// into lowercase property names and their values
+func parseDeclarations(style string) map[string]string {
+	decls := map[string]string{}
+	for _, decl := range strings.Split(cssCommentRE.ReplaceAllString(style, ""), ";") {
+		colon := strings.IndexByte(decl, ':')
+		if colon < 0 {
+			continue
+		}
+
+		prop := strings.ToLower(strings.TrimSpace(decl[:colon]))
+		value := strings.TrimSpace(decl[colon+1:])
+		value = strings.TrimSpace(strings.TrimSuffix(strings.ToLower(value), "!important"))
+		if prop != "" && value != "" {
+			decls[prop] = value
+		}
+	}
+	return decls
+}
+
+// addStyleSheet parses rules with simple selectors from the content of <style>,
+// rules with other selectors and at-rules (e.g. @media) are ignored
+func (c *converter) addStyleSheet(css string) {
+	css = cssCommentRE.ReplaceAllString(css, "")
+
+	for {
+		open := strings.IndexByte(css, '{')
+		if open < 0 {
+			return
+		}
+		prelude := strings.TrimSpace(css[:open])
+
+		// find the matching brace, at-rules may contain nested blocks
+		end, depth := open+1, 1
+		for ; end < len(css) && depth > 0; end++ {
+			switch css[end] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+		}
+		body := css[open+1 : end-1]
+		css = css[end:]
+
+		// skip at-rules without a block, like @import url(x);
+		if semi := strings.LastIndexByte(prelude, ';'); semi >= 0 {
+			prelude = strings.TrimSpace(prelude[semi+1:])
+		}
+		if strings.HasPrefix(prelude, "@") {
+			continue
+		}
+
+		decls := parseDeclarations(body)
+		for _, sel := range strings.Split(prelude, ",") {
+			m := cssSelectorRE.FindStringSubmatch(strings.ToLower(strings.TrimSpace(sel)))
+			if m == nil || m[0] == "" {
+				continue
+			}
+
+			rule := &cssRule{tagName: m[1], order: len(c.cssRules), decls: decls}
+			if m[1] != "" && m[1] != "*" {
+				rule.specificity = 1
+			}
+			for _, part := range cssSimpleSelectorRE.FindAllString(m[2], -1) {
+				if part[0] == '#' {
+					rule.ids = append(rule.ids, part[1:])
+					rule.specificity += 100
+				} else {
+					rule.classes = append(rule.classes, part[1:])
+					rule.specificity += 10
+				}
+			}
+			c.cssRules = append(c.cssRules, rule)
+		}
+	}
+}
+
+// computedStyle returns declarations applying to the element, the inline style wins
+// over rules with higher specificity which win over earlier rules
+func (c *converter) computedStyle(e *element) map[string]string {
+	var matched []*cssRule
+	for _, rule := range c.cssRules {
+		if rule.matches(e) {
+			matched = append(matched, rule)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].specificity < matched[j].specificity
+	})
+
+	style := map[string]string{}
+	if e.tagName == "pre" || e.tagName == "textarea" {
+		// user agent style sheet
+		style["white-space"] = "pre"
+	}
+	for _, rule := range matched {
+		for prop, value := range rule.decls {
+			style[prop] = value
+		}
+	}
+	for prop, value := range parseDeclarations(e.attr("style")) {
+		style[prop] = value
+	}
+	return style
+}
+
+// cssElement applies the computed style of the opened element
+func (c *converter) cssElement(e *element) {
+	style := c.computedStyle(e)
+
+	display := strings.Fields(style["display"] + " ")
+	if len(display) > 0 {
+		e.display = display[0]
+	}
+	switch e.display {
+	case "none":
+		c.hideElement(e)
+		return
+	case "block", "list-item", "table", "table-row", "table-caption", "flex", "grid", "flow-root":
+		if !blockTags[e.tagName] {
+			c.blockBreak(1)
+			if c.isOpen(e) {
+				e.onClose = append(e.onClose, func() {
+					c.blockBreak(1)
+				})
+			}
+		}
+	}
+
+	if v := style["visibility"]; v == "hidden" || v == "collapse" {
+		c.hideElement(e)
+		return
+	}
+
+	if !c.isOpen(e) {
+		return
+	}
+
+	var transform func(string) string
+	switch style["text-transform"] {
+	case "uppercase":
+		transform = strings.ToUpper
+	case "lowercase":
+		transform = strings.ToLower
+	case "capitalize":
+		transform = capitalize
+	}
+	if transform != nil {
+		c.markElement(e, "", "").transform = transform
+	}
+
+	if ws, ok := style["white-space"]; ok {
+		c.whiteSpace = append(c.whiteSpace, ws)
+		if e.tagName == "pre" {
+			// a line break right after <pre> is ignored
+			c.skipNewline = true
+		}
+		e.onClose = append(e.onClose, func() {
+			c.whiteSpace = c.whiteSpace[:len(c.whiteSpace)-1]
+		})
+	}
+}
+
+// capitalize converts the first letter of each word to upper case
+func capitalize(text string) string {
+	var sb strings.Builder
+	prevLetter := false
+	for _, r := range text {
+		if prevLetter && (r == '\'' || r == '’') {
+			// an apostrophe does not start a new word, e.g. "don't"
+			sb.WriteRune(r)
+			continue
+		}
+		isLetter := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isLetter && !prevLetter {
+			sb.WriteString(strings.ToUpper(string(r)))
+		} else {
+			sb.WriteRune(r)
+		}
+		prevLetter = isLetter
+	}
+	return sb.String()
+}
+
+// preservedWhitespace returns which whitespace should be preserved
+// according to the white-space property, newlines or also spaces
+func (c *converter) preservedWhitespace() (newlines, spaces bool) {
+	if len(c.whiteSpace) == 0 {
+		return false, false
+	}
+	switch c.whiteSpace[len(c.whiteSpace)-1] {
+	case "pre", "pre-wrap", "break-spaces":
+		return true, true
+	case "pre-line":
+		return true, false
+	}
+	return false, false
+}
+
+// writeWhitespace writes whitespace character r, preserving it if required by white-space
+func (c *converter) writeWhitespace(r rune) {
+	skipNewline := c.skipNewline
+	c.skipNewline = false
+
+	newlines, spaces := c.preservedWhitespace()
+	switch {
+	case newlines && r == '\n':
+		if !skipNewline {
+			c.writeLbr()
+		}
+	case newlines && r == '\r':
+		// part of \r\n, or an old Mac line break which is rare enough to be ignored
+	case spaces && (r == ' ' || r == '\t'):
+		c.writeText(string(r))
+	default:
+		c.writeSpace()
+	}
+}
+
+// isInline reports whether the element is displayed inline according to its style
+func (e *element) isInline() bool {
+	return e != nil && strings.HasPrefix(e.display, "inline")
+}
@@ -0,0 +1,41 @@
+package html2text
+
+// ddIndent is the indentation of definitions in <dl> lists
+const ddIndent = "    "
+
+// definitionList renders <dl> lists, terms are written on their own line
+// with the definitions indented beneath them. <dt> and <dd> end tags are
+// optional, the previous term or definition is closed by the next one.
+// In Markdown, terms and definitions are separate paragraphs and definitions
+// start with ": " as in Pandoc and PHP Markdown Extra, an indented line
+// would be a lazy continuation of the term or a code block.
+func (c *converter) definitionList(e *element) {
+	c.itemBreak()
+	if !c.isOpen(e) {
+		return
+	}
+	e.onClose = append(e.onClose, c.itemBreak)
+
+	switch e.tagName {
+	case "dt":
+		if c.opts.markdown {
+			c.markElement(e, "**", "**")
+		}
+	case "dd":
+		if c.opts.markdown {
+			c.pendingPrefix = ": "
+		} else {
+			c.pushIndent(ddIndent)
+			e.onClose = append(e.onClose, c.popIndent)
+		}
+	}
+}
+
+// itemBreak starts a new line for a term or definition, in Markdown a new paragraph
+func (c *converter) itemBreak() {
+	if c.opts.markdown {
+		c.blockBreak(2)
+	} else {
+		c.blockBreak(1)
+	}
+}
@@ -0,0 +1,98 @@
+package html2text
+
+import "strings"
+
+// detailsIndent is the indentation of the content of <details> beneath its summary
+const detailsIndent = "  "
+
+// detailsState is the state of an open <details>
+type detailsState struct {
+	elem     *element
+	open     bool
+	labelled bool // the summary line has been started
+	body     bool // the indented content has been started
+	hidden   bool // the content is hidden by WithOpenDetailsOnly
+}
+
+// WithOpenDetailsOnly instructs the converter to omit the content of closed <details>
+// elements except their summary, and <dialog> elements which are not open
+func WithOpenDetailsOnly() Option {
+	return func(o *options) {
+		o.openDetailsOnly = true
+	}
+}
+
+// detailsElement renders <details> as its summary line prefixed by a disclosure
+// triangle, followed by the indented content. rest is the html following the tag.
+func (c *converter) detailsElement(e *element, rest string) {
+	switch e.tagName {
+	case "details":
+		if !c.isOpen(e) {
+			return
+		}
+
+		st := &detailsState{elem: e, open: e.hasAttr("open")}
+		c.details = append(c.details, st)
+		e.onClose = append(e.onClose, func() {
+			// elements left open in the content, e.g. <dd>, have been closed
+			// before the details, so its indentation is the last one
+			if st.body {
+				c.popIndent()
+			}
+			if st.hidden {
+				c.badTagStackDepth--
+			}
+			c.details = c.details[:len(c.details)-1]
+		})
+
+		if !strings.HasPrefix(strings.ToLower(strings.TrimLeft(rest, " \t\r\n\f")), "<summary") {
+			// browsers show a default label for details without summary
+			st.labelled = true
+			c.blockBreak(1)
+			c.writeText(c.disclosureTriangle(st) + "Details")
+			c.startDetailsBody(st)
+		}
+
+	case "summary":
+		n := len(c.details)
+		if n == 0 || !c.isOpen(e) || len(c.stack) < 2 {
+			return
+		}
+		st := c.details[n-1]
+		if st.labelled || c.stack[len(c.stack)-2] != st.elem {
+			// only the first summary child labels the details
+			return
+		}
+
+		st.labelled = true
+		// called after the marker is closed
+		e.onClose = append(e.onClose, func() {
+			c.startDetailsBody(st)
+		})
+		c.markElement(e, c.disclosureTriangle(st), "")
+
+	case "dialog":
+		if c.opts.openDetailsOnly && !e.hasAttr("open") {
+			c.hideElement(e)
+		}
+	}
+}
+
+// disclosureTriangle returns the prefix of the summary line
+func (c *converter) disclosureTriangle(st *detailsState) string {
+	if st.open {
+		return "▾ "
+	}
+	return "▸ "
+}
+
+// startDetailsBody starts the indented content of the details on a new line
+func (c *converter) startDetailsBody(st *detailsState) {
+	c.blockBreak(1)
+	c.pushIndent(detailsIndent)
+	st.body = true
+	if !st.open && c.opts.openDetailsOnly {
+		c.badTagStackDepth++
+		st.hidden = true
+	}
+}
@@ -0,0 +1,211 @@
+package html2text
+
+import (
+	"regexp"
+	"strings"
+)
+
+var attrRE = regexp.MustCompile(`([^\s"'<>/=]+)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>` + "`" + `]+)))?`)
+
+// voidTags are elements which have no end tag
+var voidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true,
+	"img": true, "input": true, "link": true, "meta": true, "param": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// impliedEndTags lists elements whose end tag is optional when
+// the element is followed by one of the elements from the list
+var impliedEndTags = map[string][]string{
+	"rb":  {"rb", "rt", "rtc", "rp"},
+	"rt":  {"rb", "rt", "rtc", "rp"},
+	"rtc": {"rb", "rtc", "rp"},
+	"rp":  {"rb", "rt", "rtc", "rp"},
+
+	"option":   {"option", "optgroup"},
+	"optgroup": {"optgroup"},
+}
+
+// closesP lists elements whose start tag closes an open <p>
+var closesP = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "center": true,
+	"details": true, "dialog": true, "dir": true, "div": true, "dl": true, "dd": true, "dt": true,
+	"fieldset": true, "figcaption": true, "figure": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "header": true,
+	"hgroup": true, "hr": true, "li": true, "main": true, "menu": true, "nav": true, "ol": true,
+	"p": true, "pre": true, "section": true, "summary": true, "table": true, "ul": true,
+}
+
+// Scopes limit the search for an element closed implicitly by a start tag,
+// e.g. <li> does not close an <li> of an outer list.
+var (
+	buttonScope = map[string]bool{
+		"applet": true, "button": true, "caption": true, "html": true, "marquee": true,
+		"object": true, "table": true, "td": true, "th": true, "template": true,
+	}
+	listItemScope = map[string]bool{
+		"applet": true, "article": true, "aside": true, "blockquote": true, "button": true,
+		"caption": true, "details": true, "dialog": true, "dl": true, "fieldset": true,
+		"figure": true, "footer": true, "form": true, "header": true, "html": true, "main": true,
+		"marquee": true, "menu": true, "nav": true, "object": true, "ol": true, "section": true,
+		"table": true, "td": true, "template": true, "th": true, "ul": true,
+	}
+	tableScope = map[string]bool{"html": true, "table": true, "template": true}
+	rowScope   = map[string]bool{"html": true, "table": true, "template": true, "tr": true}
+)
+
+// element is an open element
+type element struct {
+	tagName string
+	tag     string // the text between < and >
+	attrs   map[string]string
+	// the display property set by WithCSS, empty if not set
+	display string
+	// the content is hidden by the element itself, see hideElement
+	hidden bool
+	// called in reverse order when the element is closed
+	onClose []func()
+}
+
+// attr returns the entity-decoded value of the attribute with the lowercase name
+func (e *element) attr(name string) string {
+	if e.attrs == nil {
+		e.attrs = parseAttrs(e.tag)
+	}
+	return e.attrs[name]
+}
+
+// hasAttr reports whether the element has the attribute with the lowercase name
+func (e *element) hasAttr(name string) bool {
+	e.attr(name)
+	_, ok := e.attrs[name]
+	return ok
+}
+
+// parseAttrs returns the attributes of the tag (the text between < and >)
+// with lowercase names and entity-decoded values
+func parseAttrs(tag string) map[string]string {
+	attrs := map[string]string{}
+
+	// skip the tag name
+	start := strings.IndexAny(tag, " \t\r\n\f/")
+	if start < 0 {
+		return attrs
+	}
+
+	for _, m := range attrRE.FindAllStringSubmatch(tag[start:], -1) {
+		name := strings.ToLower(m[1])
+		if _, ok := attrs[name]; !ok {
+			attrs[name] = HTMLEntitiesToText(m[2] + m[3] + m[4])
+		}
+	}
+	return attrs
+}
+
+// openElement pushes a new element to the stack of open elements
+// and returns it, void and self-closing elements are not pushed
+func (c *converter) openElement(tagName, tag string) *element {
+	switch tagName {
+	case "li":
+		c.closeInScope(listItemScope, "li")
+	case "dd", "dt":
+		c.closeInScope(listItemScope, "dd", "dt")
+	case "tr":
+		c.closeInScope(tableScope, "tr")
+	case "td", "th":
+		c.closeInScope(rowScope, "td", "th")
+	case "tbody", "thead", "tfoot":
+		c.closeInScope(tableScope, "tbody", "thead", "tfoot")
+	case "select":
+		// a select cannot contain another one
+		c.closeElement("select")
+	}
+	if closesP[tagName] {
+		c.closeInScope(buttonScope, "p")
+	}
+
+	if n := len(c.stack); n > 0 {
+		for _, next := range impliedEndTags[c.stack[n-1].tagName] {
+			if next == tagName {
+				c.closeElements(n - 1)
+				break
+			}
+		}
+	}
+
+	e := &element{tagName: tagName, tag: tag}
+	if !voidTags[tagName] && !strings.HasSuffix(tag, "/") {
+		c.stack = append(c.stack, e)
+	}
+	return e
+}
+
+// closeInScope closes the innermost open element with one of the tag names,
+// unless an element of the scope is open after it, as for optional end tags
+func (c *converter) closeInScope(scope map[string]bool, tagNames ...string) {
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		name := c.stack[i].tagName
+		for _, tagName := range tagNames {
+			if name == tagName {
+				c.closeElements(i)
+				return
+			}
+		}
+		if scope[name] {
+			return
+		}
+	}
+}
+
+// isOpen reports whether e is the innermost open element, it is not for void elements
+func (c *converter) isOpen(e *element) bool {
+	return len(c.stack) > 0 && c.stack[len(c.stack)-1] == e
+}
+
+// closeElement pops the last open element with the tag name from the stack
+// and returns it, elements opened after it are closed implicitly
+func (c *converter) closeElement(tagName string) *element {
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		if e := c.stack[i]; e.tagName == tagName {
+			c.closeElements(i)
+			return e
+		}
+	}
+	return nil
+}
+
+// closeElements closes all open elements from the depth on
+func (c *converter) closeElements(depth int) {
+	for len(c.stack) > depth {
+		e := c.stack[len(c.stack)-1]
+		c.stack = c.stack[:len(c.stack)-1]
+		for j := len(e.onClose) - 1; j >= 0; j-- {
+			e.onClose[j]()
+		}
+	}
+}
+
+// markElement marks the text of the open element by the open and close strings
+// until the element is closed, see pushMarker. It returns nil for void and
+// self-closing elements which have no text.
+func (c *converter) markElement(e *element, open, close string) *marker {
+	if !c.isOpen(e) {
+		return nil
+	}
+
+	m := c.pushMarker(e.tagName, open, close)
+	e.onClose = append(e.onClose, func() {
+		c.closeMarker(m)
+	})
+	return m
+}
+
+// closest returns the value of the attribute of the innermost open element having it
+func (c *converter) closest(attr string) string {
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		if v := c.stack[i].attr(attr); v != "" {
+			return v
+		}
+	}
+	return ""
+}
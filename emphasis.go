@@ -0,0 +1,47 @@
+package html2text
+
+// plainEmphasis are the markers used by WithEmphasis
+var plainEmphasis = map[string]string{
+	"b": "*", "strong": "*",
+	"i": "/", "em": "/",
+	"u":   "_",
+	"del": "~~", "s": "~~", "strike": "~~",
+	"mark": "==",
+	"code": "`",
+}
+
+// markdownEmphasis are the markers used in Markdown mode
+var markdownEmphasis = map[string]string{
+	"b": "**", "strong": "**",
+	"i": "*", "em": "*",
+	"del": "~~", "s": "~~", "strike": "~~",
+	"mark": "==",
+	"code": "`",
+}
+
+// WithEmphasis instructs the converter to wrap the text of inline elements
+// <strong>, <b>, <em>, <i>, <u>, <del>, <s>, <mark> and <code> into markers
+// Example: <b>bold</b> <i>italic</i> => *bold* /italic/
+// Markdown mode uses Markdown emphasis (**bold** *italic*) without this option.
+func WithEmphasis() Option {
+	return WithEmphasisMarkers(plainEmphasis)
+}
+
+// WithEmphasisMarkers instructs the converter to wrap the text of inline elements into markers
+// specified by the tag name, e.g. map[string]string{"b": "*", "code": "`"}
+func WithEmphasisMarkers(markers map[string]string) Option {
+	return func(o *options) {
+		o.emphasis = markers
+	}
+}
+
+// emphasisMarker returns the marker of the inline element
+func (c *converter) emphasisMarker(tagName string) (string, bool) {
+	markers := c.opts.emphasis
+	if markers == nil && c.opts.markdown {
+		markers = markdownEmphasis
+	}
+
+	marker, ok := markers[tagName]
+	return marker, ok && marker != ""
+}
@@ -0,0 +1,592 @@
+// Code generated by gen_entity.go from entities.json; DO NOT EDIT.
+
+package html2text
+
+// entityNames contains all 2125 entity names sorted and concatenated
+const entityNames = "AEligAMPAacuteAbreveAcircAcyAfrAgraveAlphaAmacrAndAogonAopfApply" +
+	"FunctionAringAscrAssignAtildeAumlBackslashBarvBarwedBcyBecauseBe" +
+	"rnoullisBetaBfrBopfBreveBscrBumpeqCHcyCOPYCacuteCapCapitalDiffer" +
+	"entialDCayleysCcaronCcedilCcircCconintCdotCedillaCenterDotCfrChi" +
+	"CircleDotCircleMinusCirclePlusCircleTimesClockwiseContourIntegra" +
+	"lCloseCurlyDoubleQuoteCloseCurlyQuoteColonColoneCongruentConintC" +
+	"ontourIntegralCopfCoproductCounterClockwiseContourIntegralCrossC" +
+	"scrCupCupCapDDDDotrahdDJcyDScyDZcyDaggerDarrDashvDcaronDcyDelDel" +
+	"taDfrDiacriticalAcuteDiacriticalDotDiacriticalDoubleAcuteDiacrit" +
+	"icalGraveDiacriticalTildeDiamondDifferentialDDopfDotDotDotDotEqu" +
+	"alDoubleContourIntegralDoubleDotDoubleDownArrowDoubleLeftArrowDo" +
+	"ubleLeftRightArrowDoubleLeftTeeDoubleLongLeftArrowDoubleLongLeft" +
+	"RightArrowDoubleLongRightArrowDoubleRightArrowDoubleRightTeeDoub" +
+	"leUpArrowDoubleUpDownArrowDoubleVerticalBarDownArrowDownArrowBar" +
+	"DownArrowUpArrowDownBreveDownLeftRightVectorDownLeftTeeVectorDow" +
+	"nLeftVectorDownLeftVectorBarDownRightTeeVectorDownRightVectorDow" +
+	"nRightVectorBarDownTeeDownTeeArrowDownarrowDscrDstrokENGETHEacut" +
+	"eEcaronEcircEcyEdotEfrEgraveElementEmacrEmptySmallSquareEmptyVer" +
+	"ySmallSquareEogonEopfEpsilonEqualEqualTildeEquilibriumEscrEsimEt" +
+	"aEumlExistsExponentialEFcyFfrFilledSmallSquareFilledVerySmallSqu" +
+	"areFopfForAllFouriertrfFscrGJcyGTGammaGammadGbreveGcedilGcircGcy" +
+	"GdotGfrGgGopfGreaterEqualGreaterEqualLessGreaterFullEqualGreater" +
+	"GreaterGreaterLessGreaterSlantEqualGreaterTildeGscrGtHARDcyHacek" +
+	"HatHcircHfrHilbertSpaceHopfHorizontalLineHscrHstrokHumpDownHumpH" +
+	"umpEqualIEcyIJligIOcyIacuteIcircIcyIdotIfrIgraveImImacrImaginary" +
+	"IImpliesIntIntegralIntersectionInvisibleCommaInvisibleTimesIogon" +
+	"IopfIotaIscrItildeIukcyIumlJcircJcyJfrJopfJscrJsercyJukcyKHcyKJc" +
+	"yKappaKcedilKcyKfrKopfKscrLJcyLTLacuteLambdaLangLaplacetrfLarrLc" +
+	"aronLcedilLcyLeftAngleBracketLeftArrowLeftArrowBarLeftArrowRight" +
+	"ArrowLeftCeilingLeftDoubleBracketLeftDownTeeVectorLeftDownVector" +
+	"LeftDownVectorBarLeftFloorLeftRightArrowLeftRightVectorLeftTeeLe" +
+	"ftTeeArrowLeftTeeVectorLeftTriangleLeftTriangleBarLeftTriangleEq" +
+	"ualLeftUpDownVectorLeftUpTeeVectorLeftUpVectorLeftUpVectorBarLef" +
+	"tVectorLeftVectorBarLeftarrowLeftrightarrowLessEqualGreaterLessF" +
+	"ullEqualLessGreaterLessLessLessSlantEqualLessTildeLfrLlLleftarro" +
+	"wLmidotLongLeftArrowLongLeftRightArrowLongRightArrowLongleftarro" +
+	"wLongleftrightarrowLongrightarrowLopfLowerLeftArrowLowerRightArr" +
+	"owLscrLshLstrokLtMapMcyMediumSpaceMellintrfMfrMinusPlusMopfMscrM" +
+	"uNJcyNacuteNcaronNcedilNcyNegativeMediumSpaceNegativeThickSpaceN" +
+	"egativeThinSpaceNegativeVeryThinSpaceNestedGreaterGreaterNestedL" +
+	"essLessNewLineNfrNoBreakNonBreakingSpaceNopfNotNotCongruentNotCu" +
+	"pCapNotDoubleVerticalBarNotElementNotEqualNotEqualTildeNotExists" +
+	"NotGreaterNotGreaterEqualNotGreaterFullEqualNotGreaterGreaterNot" +
+	"GreaterLessNotGreaterSlantEqualNotGreaterTildeNotHumpDownHumpNot" +
+	"HumpEqualNotLeftTriangleNotLeftTriangleBarNotLeftTriangleEqualNo" +
+	"tLessNotLessEqualNotLessGreaterNotLessLessNotLessSlantEqualNotLe" +
+	"ssTildeNotNestedGreaterGreaterNotNestedLessLessNotPrecedesNotPre" +
+	"cedesEqualNotPrecedesSlantEqualNotReverseElementNotRightTriangle" +
+	"NotRightTriangleBarNotRightTriangleEqualNotSquareSubsetNotSquare" +
+	"SubsetEqualNotSquareSupersetNotSquareSupersetEqualNotSubsetNotSu" +
+	"bsetEqualNotSucceedsNotSucceedsEqualNotSucceedsSlantEqualNotSucc" +
+	"eedsTildeNotSupersetNotSupersetEqualNotTildeNotTildeEqualNotTild" +
+	"eFullEqualNotTildeTildeNotVerticalBarNscrNtildeNuOEligOacuteOcir" +
+	"cOcyOdblacOfrOgraveOmacrOmegaOmicronOopfOpenCurlyDoubleQuoteOpen" +
+	"CurlyQuoteOrOscrOslashOtildeOtimesOumlOverBarOverBraceOverBracke" +
+	"tOverParenthesisPartialDPcyPfrPhiPiPlusMinusPoincareplanePopfPrP" +
+	"recedesPrecedesEqualPrecedesSlantEqualPrecedesTildePrimeProductP" +
+	"roportionProportionalPscrPsiQUOTQfrQopfQscrRBarrREGRacuteRangRar" +
+	"rRarrtlRcaronRcedilRcyReReverseElementReverseEquilibriumReverseU" +
+	"pEquilibriumRfrRhoRightAngleBracketRightArrowRightArrowBarRightA" +
+	"rrowLeftArrowRightCeilingRightDoubleBracketRightDownTeeVectorRig" +
+	"htDownVectorRightDownVectorBarRightFloorRightTeeRightTeeArrowRig" +
+	"htTeeVectorRightTriangleRightTriangleBarRightTriangleEqualRightU" +
+	"pDownVectorRightUpTeeVectorRightUpVectorRightUpVectorBarRightVec" +
+	"torRightVectorBarRightarrowRopfRoundImpliesRrightarrowRscrRshRul" +
+	"eDelayedSHCHcySHcySOFTcySacuteScScaronScedilScircScySfrShortDown" +
+	"ArrowShortLeftArrowShortRightArrowShortUpArrowSigmaSmallCircleSo" +
+	"pfSqrtSquareSquareIntersectionSquareSubsetSquareSubsetEqualSquar" +
+	"eSupersetSquareSupersetEqualSquareUnionSscrStarSubSubsetSubsetEq" +
+	"ualSucceedsSucceedsEqualSucceedsSlantEqualSucceedsTildeSuchThatS" +
+	"umSupSupersetSupersetEqualSupsetTHORNTRADETSHcyTScyTabTauTcaronT" +
+	"cedilTcyTfrThereforeThetaThickSpaceThinSpaceTildeTildeEqualTilde" +
+	"FullEqualTildeTildeTopfTripleDotTscrTstrokUacuteUarrUarrocirUbrc" +
+	"yUbreveUcircUcyUdblacUfrUgraveUmacrUnderBarUnderBraceUnderBracke" +
+	"tUnderParenthesisUnionUnionPlusUogonUopfUpArrowUpArrowBarUpArrow" +
+	"DownArrowUpDownArrowUpEquilibriumUpTeeUpTeeArrowUparrowUpdownarr" +
+	"owUpperLeftArrowUpperRightArrowUpsiUpsilonUringUscrUtildeUumlVDa" +
+	"shVbarVcyVdashVdashlVeeVerbarVertVerticalBarVerticalLineVertical" +
+	"SeparatorVerticalTildeVeryThinSpaceVfrVopfVscrVvdashWcircWedgeWf" +
+	"rWopfWscrXfrXiXopfXscrYAcyYIcyYUcyYacuteYcircYcyYfrYopfYscrYumlZ" +
+	"HcyZacuteZcaronZcyZdotZeroWidthSpaceZetaZfrZopfZscraacuteabrevea" +
+	"cacEacdacircacuteacyaeligafafragravealefsymalephalphaamacramalga" +
+	"mpandandandanddandslopeandvangangeangleangmsdangmsdaaangmsdabang" +
+	"msdacangmsdadangmsdaeangmsdafangmsdagangmsdahangrtangrtvbangrtvb" +
+	"dangsphangstangzarraogonaopfapapEapacirapeapidaposapproxapproxeq" +
+	"aringascrastasympasympeqatildeaumlawconintawintbNotbackcongbacke" +
+	"psilonbackprimebacksimbacksimeqbarveebarwedbarwedgebbrkbbrktbrkb" +
+	"congbcybdquobecausbecausebemptyvbepsibernoubetabethbetweenbfrbig" +
+	"capbigcircbigcupbigodotbigoplusbigotimesbigsqcupbigstarbigtriang" +
+	"ledownbigtriangleupbiguplusbigveebigwedgebkarowblacklozengeblack" +
+	"squareblacktriangleblacktriangledownblacktriangleleftblacktriang" +
+	"lerightblankblk12blk14blk34blockbnebnequivbnotbopfbotbottombowti" +
+	"eboxDLboxDRboxDlboxDrboxHboxHDboxHUboxHdboxHuboxULboxURboxUlboxU" +
+	"rboxVboxVHboxVLboxVRboxVhboxVlboxVrboxboxboxdLboxdRboxdlboxdrbox" +
+	"hboxhDboxhUboxhdboxhuboxminusboxplusboxtimesboxuLboxuRboxulboxur" +
+	"boxvboxvHboxvLboxvRboxvhboxvlboxvrbprimebrevebrvbarbscrbsemibsim" +
+	"bsimebsolbsolbbsolhsubbullbulletbumpbumpEbumpebumpeqcacutecapcap" +
+	"andcapbrcupcapcapcapcupcapdotcapscaretcaronccapsccaronccedilccir" +
+	"cccupsccupssmcdotcedilcemptyvcentcenterdotcfrchcycheckcheckmarkc" +
+	"hicircirEcirccirceqcirclearrowleftcirclearrowrightcircledRcircle" +
+	"dScircledastcircledcirccircleddashcirecirfnintcirmidcirscirclubs" +
+	"clubsuitcoloncolonecoloneqcommacommatcompcompfncomplementcomplex" +
+	"escongcongdotconintcopfcoprodcopycopysrcrarrcrosscscrcsubcsubecs" +
+	"upcsupectdotcudarrlcudarrrcueprcuesccularrcularrpcupcupbrcapcupc" +
+	"apcupcupcupdotcuporcupscurarrcurarrmcurlyeqpreccurlyeqsucccurlyv" +
+	"eecurlywedgecurrencurvearrowleftcurvearrowrightcuveecuwedcwconin" +
+	"tcwintcylctydArrdHardaggerdalethdarrdashdashvdbkarowdblacdcarond" +
+	"cyddddaggerddarrddotseqdegdeltademptyvdfishtdfrdharldharrdiamdia" +
+	"monddiamondsuitdiamsdiedigammadisindivdividedivideontimesdivonxd" +
+	"jcydlcorndlcropdollardopfdotdoteqdoteqdotdotminusdotplusdotsquar" +
+	"edoublebarwedgedownarrowdowndownarrowsdownharpoonleftdownharpoon" +
+	"rightdrbkarowdrcorndrcropdscrdscydsoldstrokdtdotdtridtrifduarrdu" +
+	"hardwangledzcydzigrarreDDoteDoteacuteeasterecaronecirecircecolon" +
+	"ecyedoteeefDotefregegraveegsegsdotelelintersellelselsdotemacremp" +
+	"tyemptysetemptyvemspemsp13emsp14engenspeogoneopfepareparslepluse" +
+	"psiepsilonepsiveqcirceqcoloneqsimeqslantgtreqslantlessequalseque" +
+	"stequivequivDDeqvparslerDoterarrescresdotesimetaetheumleuroexcle" +
+	"xistexpectationexponentialefallingdotseqfcyfemaleffiligffligffll" +
+	"igffrfiligfjligflatflligfltnsfnoffopfforallforkforkvfpartintfrac" +
+	"12frac13frac14frac15frac16frac18frac23frac25frac34frac35frac38fr" +
+	"ac45frac56frac58frac78fraslfrownfscrgEgElgacutegammagammadgapgbr" +
+	"evegcircgcygdotgegelgeqgeqqgeqslantgesgesccgesdotgesdotogesdotol" +
+	"geslgeslesgfrggggggimelgjcyglglEglagljgnEgnapgnapproxgnegneqgneq" +
+	"qgnsimgopfgravegscrgsimgsimegsimlgtgtccgtcirgtdotgtlPargtquestgt" +
+	"rapproxgtrarrgtrdotgtreqlessgtreqqlessgtrlessgtrsimgvertneqqgvnE" +
+	"hArrhairsphalfhamilthardcyharrharrcirharrwhbarhcircheartsheartsu" +
+	"ithellipherconhfrhksearowhkswarowhoarrhomththookleftarrowhookrig" +
+	"htarrowhopfhorbarhscrhslashhstrokhybullhypheniacuteicicircicyiec" +
+	"yiexcliffifrigraveiiiiiintiiintiinfiniiotaijligimacrimageimaglin" +
+	"eimagpartimathimofimpedinincareinfininfintieinodotintintcalinteg" +
+	"ersintercalintlarhkintprodiocyiogoniopfiotaiprodiquestiscrisinis" +
+	"inEisindotisinsisinsvisinvititildeiukcyiumljcircjcyjfrjmathjopfj" +
+	"scrjsercyjukcykappakappavkcedilkcykfrkgreenkhcykjcykopfkscrlAarr" +
+	"lArrlAtaillBarrlElEglHarlacutelaemptyvlagranlambdalanglangdlangl" +
+	"elaplaquolarrlarrblarrbfslarrfslarrhklarrlplarrpllarrsimlarrtlla" +
+	"tlataillatelateslbarrlbbrklbracelbracklbrkelbrksldlbrkslulcaronl" +
+	"cedillceillcublcyldcaldquoldquorldrdharldrusharldshleleftarrowle" +
+	"ftarrowtailleftharpoondownleftharpoonupleftleftarrowsleftrightar" +
+	"rowleftrightarrowsleftrightharpoonsleftrightsquigarrowleftthreet" +
+	"imeslegleqleqqleqslantleslescclesdotlesdotolesdotorlesglesgesles" +
+	"sapproxlessdotlesseqgtrlesseqqgtrlessgtrlesssimlfishtlfloorlfrlg" +
+	"lgElhardlharulharullhblkljcyllllarrllcornerllhardlltrilmidotlmou" +
+	"stlmoustachelnElnaplnapproxlnelneqlneqqlnsimloangloarrlobrklongl" +
+	"eftarrowlongleftrightarrowlongmapstolongrightarrowlooparrowleftl" +
+	"ooparrowrightloparlopflopluslotimeslowastlowbarlozlozengelozflpa" +
+	"rlparltlrarrlrcornerlrharlrhardlrmlrtrilsaquolscrlshlsimlsimelsi" +
+	"mglsqblsquolsquorlstrokltltccltcirltdotlthreeltimesltlarrltquest" +
+	"ltrParltriltrieltriflurdsharluruharlvertneqqlvnEmDDotmacrmalemal" +
+	"tmaltesemapmapstomapstodownmapstoleftmapstoupmarkermcommamcymdas" +
+	"hmeasuredanglemfrmhomicromidmidastmidcirmiddotminusminusbminusdm" +
+	"inusdumlcpmldrmnplusmodelsmopfmpmscrmstposmumultimapmumapnGgnGtn" +
+	"GtvnLeftarrownLeftrightarrownLlnLtnLtvnRightarrownVDashnVdashnab" +
+	"lanacutenangnapnapEnapidnaposnapproxnaturnaturalnaturalsnbspnbum" +
+	"pnbumpencapncaronncedilncongncongdotncupncyndashneneArrnearhknea" +
+	"rrnearrownedotnequivnesearnesimnexistnexistsnfrngEngengeqngeqqng" +
+	"eqslantngesngsimngtngtrnhArrnharrnhparninisnisdnivnjcynlArrnlEnl" +
+	"arrnldrnlenleftarrownleftrightarrownleqnleqqnleqslantnlesnlessnl" +
+	"simnltnltrinltrienmidnopfnotnotinnotinEnotindotnotinvanotinvbnot" +
+	"invcnotninotnivanotnivbnotnivcnparnparallelnparslnpartnpolintnpr" +
+	"nprcuenprenprecnpreceqnrArrnrarrnrarrcnrarrwnrightarrownrtrinrtr" +
+	"ienscnsccuenscenscrnshortmidnshortparallelnsimnsimensimeqnsmidns" +
+	"parnsqsubensqsupensubnsubEnsubensubsetnsubseteqnsubseteqqnsuccns" +
+	"ucceqnsupnsupEnsupensupsetnsupseteqnsupseteqqntglntildentlgntria" +
+	"ngleleftntrianglelefteqntrianglerightntrianglerighteqnunumnumero" +
+	"numspnvDashnvHarrnvapnvdashnvgenvgtnvinfinnvlArrnvlenvltnvltrien" +
+	"vrArrnvrtrienvsimnwArrnwarhknwarrnwarrownwnearoSoacuteoastociroc" +
+	"ircocyodashodblacodivodotodsoldoeligofcirofrogonograveogtohbaroh" +
+	"mointolarrolcirolcrossolineoltomacromegaomicronomidominusoopfopa" +
+	"roperpoplusororarrordorderorderofordfordmorigoforororslopeorvosc" +
+	"roslashosolotildeotimesotimesasoumlovbarparparaparallelparsimpar" +
+	"slpartpcypercntperiodpermilperppertenkpfrphiphivphmmatphonepipit" +
+	"chforkpivplanckplanckhplankvplusplusacirplusbpluscirplusdoplusdu" +
+	"pluseplusmnplussimplustwopmpointintpopfpoundprprEprapprcueprepre" +
+	"cprecapproxpreccurlyeqpreceqprecnapproxprecneqqprecnsimprecsimpr" +
+	"imeprimesprnEprnapprnsimprodprofalarproflineprofsurfpropproptopr" +
+	"simprurelpscrpsipuncspqfrqintqopfqprimeqscrquaternionsquatintque" +
+	"stquesteqquotrAarrrArrrAtailrBarrrHarraceracuteradicraemptyvrang" +
+	"rangdrangerangleraquorarrrarraprarrbrarrbfsrarrcrarrfsrarrhkrarr" +
+	"lprarrplrarrsimrarrtlrarrwratailratiorationalsrbarrrbbrkrbracerb" +
+	"rackrbrkerbrksldrbrkslurcaronrcedilrceilrcubrcyrdcardldharrdquor" +
+	"dquorrdshrealrealinerealpartrealsrectregrfishtrfloorrfrrhardrhar" +
+	"urharulrhorhovrightarrowrightarrowtailrightharpoondownrightharpo" +
+	"onuprightleftarrowsrightleftharpoonsrightrightarrowsrightsquigar" +
+	"rowrightthreetimesringrisingdotseqrlarrrlharrlmrmoustrmoustacher" +
+	"nmidroangroarrrobrkroparropfroplusrotimesrparrpargtrppolintrrarr" +
+	"rsaquorscrrshrsqbrsquorsquorrthreertimesrtrirtriertrifrtriltriru" +
+	"luharrxsacutesbquoscscEscapscaronsccuescescedilscircscnEscnapscn" +
+	"simscpolintscsimscysdotsdotbsdoteseArrsearhksearrsearrowsectsemi" +
+	"seswarsetminussetmnsextsfrsfrownsharpshchcyshcyshortmidshortpara" +
+	"llelshysigmasigmafsigmavsimsimdotsimesimeqsimgsimgEsimlsimlEsimn" +
+	"esimplussimrarrslarrsmallsetminussmashpsmeparslsmidsmilesmtsmtes" +
+	"mtessoftcysolsolbsolbarsopfspadesspadesuitsparsqcapsqcapssqcupsq" +
+	"cupssqsubsqsubesqsubsetsqsubseteqsqsupsqsupesqsupsetsqsupseteqsq" +
+	"usquaresquarfsqufsrarrsscrssetmnssmilesstarfstarstarfstraighteps" +
+	"ilonstraightphistrnssubsubEsubdotsubesubedotsubmultsubnEsubnesub" +
+	"plussubrarrsubsetsubseteqsubseteqqsubsetneqsubsetneqqsubsimsubsu" +
+	"bsubsupsuccsuccapproxsucccurlyeqsucceqsuccnapproxsuccneqqsuccnsi" +
+	"msuccsimsumsungsupsup1sup2sup3supEsupdotsupdsubsupesupedotsuphso" +
+	"lsuphsubsuplarrsupmultsupnEsupnesupplussupsetsupseteqsupseteqqsu" +
+	"psetneqsupsetneqqsupsimsupsubsupsupswArrswarhkswarrswarrowswnwar" +
+	"szligtargettautbrktcarontcediltcytdottelrectfrthere4thereforethe" +
+	"tathetasymthetavthickapproxthicksimthinspthkapthksimthorntildeti" +
+	"mestimesbtimesbartimesdtinttoeatoptopbottopcirtopftopforktosatpr" +
+	"imetradetriangletriangledowntrianglelefttrianglelefteqtriangleqt" +
+	"rianglerighttrianglerighteqtridottrietriminustriplustrisbtritime" +
+	"trpeziumtscrtscytshcytstroktwixttwoheadleftarrowtwoheadrightarro" +
+	"wuArruHaruacuteuarrubrcyubreveucircucyudarrudblacudharufishtufru" +
+	"graveuharluharruhblkulcornulcornerulcropultriumacrumluogonuopfup" +
+	"arrowupdownarrowupharpoonleftupharpoonrightuplusupsiupsihupsilon" +
+	"upuparrowsurcornurcornerurcropuringurtriuscrutdotutildeutriutrif" +
+	"uuarruumluwanglevArrvBarvBarvvDashvangrtvarepsilonvarkappavarnot" +
+	"hingvarphivarpivarproptovarrvarrhovarsigmavarsubsetneqvarsubsetn" +
+	"eqqvarsupsetneqvarsupsetneqqvarthetavartriangleleftvartriangleri" +
+	"ghtvcyvdashveeveebarveeeqvellipverbarvertvfrvltrivnsubvnsupvopfv" +
+	"propvrtrivscrvsubnEvsubnevsupnEvsupnevzigzagwcircwedbarwedgewedg" +
+	"eqweierpwfrwopfwpwrwreathwscrxcapxcircxcupxdtrixfrxhArrxharrxixl" +
+	"ArrxlarrxmapxnisxodotxopfxoplusxotimexrArrxrarrxscrxsqcupxuplusx" +
+	"utrixveexwedgeyacuteyacyycircycyyenyfryicyyopfyscryucyyumlzacute" +
+	"zcaronzcyzdotzeetrfzetazfrzhcyzigrarrzopfzscrzwjzwnj"
+
+// entityValues contains the values of entityNames in the same order
+const entityValues = "\u00c6&\u00c1\u0102\u00c2\u0410\U0001d504\u00c0\u0391\u0100\u2a53\u0104\U0001d538\u2061\u00c5\U0001d49c\u2254\u00c3\u00c4\u2216\u2ae7\u2306\u0411\u2235\u212c\u0392" +
+	"\U0001d505\U0001d539\u02d8\u212c\u224e\u0427\u00a9\u0106\u22d2\u2145\u212d\u010c\u00c7\u0108\u2230\u010a\u00b8\u00b7\u212d\u03a7\u2299\u2296\u2295\u2297\u2232" +
+	"\u201d\u2019\u2237\u2a74\u2261\u222f\u222e\u2102\u2210\u2233\u2a2f\U0001d49e\u22d3\u224d\u2145\u2911\u0402\u0405\u040f\u2021\u21a1\u2ae4" +
+	"\u010e\u0414\u2207\u0394\U0001d507\u00b4\u02d9\u02dd`\u02dc\u22c4\u2146\U0001d53b\u00a8\u20dc\u2250\u222f\u00a8\u21d3\u21d0\u21d4\u2ae4\u27f8\u27fa\u27f9" +
+	"\u21d2\u22a8\u21d1\u21d5\u2225\u2193\u2913\u21f5\u0311\u2950\u295e\u21bd\u2956\u295f\u21c1\u2957\u22a4\u21a7\u21d3\U0001d49f\u0110\u014a" +
+	"\u00d0\u00c9\u011a\u00ca\u042d\u0116\U0001d508\u00c8\u2208\u0112\u25fb\u25ab\u0118\U0001d53c\u0395\u2a75\u2242\u21cc\u2130\u2a73\u0397\u00cb\u2203\u2147\u0424" +
+	"\U0001d509\u25fc\u25aa\U0001d53d\u2200\u2131\u2131\u0403>\u0393\u03dc\u011e\u0122\u011c\u0413\u0120\U0001d50a\u22d9\U0001d53e\u2265\u22db\u2267\u2aa2\u2277" +
+	"\u2a7e\u2273\U0001d4a2\u226b\u042a\u02c7^\u0124\u210c\u210b\u210d\u2500\u210b\u0126\u224e\u224f\u0415\u0132\u0401\u00cd\u00ce\u0418\u0130\u2111\u00cc\u2111" +
+	"\u012a\u2148\u21d2\u222c\u222b\u22c2\u2063\u2062\u012e\U0001d540\u0399\u2110\u0128\u0406\u00cf\u0134\u0419\U0001d50d\U0001d541\U0001d4a5\u0408\u0404\u0425\u040c" +
+	"\u039a\u0136\u041a\U0001d50e\U0001d542\U0001d4a6\u0409<\u0139\u039b\u27ea\u2112\u219e\u013d\u013b\u041b\u27e8\u2190\u21e4\u21c6\u2308\u27e6\u2961\u21c3" +
+	"\u2959\u230a\u2194\u294e\u22a3\u21a4\u295a\u22b2\u29cf\u22b4\u2951\u2960\u21bf\u2958\u21bc\u2952\u21d0\u21d4\u22da\u2266\u2276\u2aa1" +
+	"\u2a7d\u2272\U0001d50f\u22d8\u21da\u013f\u27f5\u27f7\u27f6\u27f8\u27fa\u27f9\U0001d543\u2199\u2198\u2112\u21b0\u0141\u226a\u2905\u041c\u205f" +
+	"\u2133\U0001d510\u2213\U0001d544\u2133\u039c\u040a\u0143\u0147\u0145\u041d\u200b\u200b\u200b\u200b\u226b\u226a\n\U0001d511\u2060\u00a0\u2115\u2aec\u2262" +
+	"\u226d\u2226\u2209\u2260\u2242\u0338\u2204\u226f\u2271\u2267\u0338\u226b\u0338\u2279\u2a7e\u0338\u2275\u224e\u0338\u224f\u0338\u22ea\u29cf\u0338" +
+	"\u22ec\u226e\u2270\u2278\u226a\u0338\u2a7d\u0338\u2274\u2aa2\u0338\u2aa1\u0338\u2280\u2aaf\u0338\u22e0\u220c\u22eb\u29d0\u0338\u22ed\u228f\u0338" +
+	"\u22e2\u2290\u0338\u22e3\u2282\u20d2\u2288\u2281\u2ab0\u0338\u22e1\u227f\u0338\u2283\u20d2\u2289\u2241\u2244\u2247\u2249\u2224\U0001d4a9" +
+	"\u00d1\u039d\u0152\u00d3\u00d4\u041e\u0150\U0001d512\u00d2\u014c\u03a9\u039f\U0001d546\u201c\u2018\u2a54\U0001d4aa\u00d8\u00d5\u2a37\u00d6\u203e\u23de\u23b4\u23dc" +
+	"\u2202\u041f\U0001d513\u03a6\u03a0\u00b1\u210c\u2119\u2abb\u227a\u2aaf\u227c\u227e\u2033\u220f\u2237\u221d\U0001d4ab\u03a8\"\U0001d514\u211a\U0001d4ac" +
+	"\u2910\u00ae\u0154\u27eb\u21a0\u2916\u0158\u0156\u0420\u211c\u220b\u21cb\u296f\u211c\u03a1\u27e9\u2192\u21e5\u21c4\u2309\u27e7\u295d\u21c2\u2955" +
+	"\u230b\u22a2\u21a6\u295b\u22b3\u29d0\u22b5\u294f\u295c\u21be\u2954\u21c0\u2953\u21d2\u211d\u2970\u21db\u211b\u21b1\u29f4\u0429\u0428" +
+	"\u042c\u015a\u2abc\u0160\u015e\u015c\u0421\U0001d516\u2193\u2190\u2192\u2191\u03a3\u2218\U0001d54a\u221a\u25a1\u2293\u228f\u2291\u2290\u2292\u2294" +
+	"\U0001d4ae\u22c6\u22d0\u22d0\u2286\u227b\u2ab0\u227d\u227f\u220b\u2211\u22d1\u2283\u2287\u22d1\u00de\u2122\u040b\u0426\t\u03a4\u0164\u0162\u0422" +
+	"\U0001d517\u2234\u0398\u205f\u200a\u2009\u223c\u2243\u2245\u2248\U0001d54b\u20db\U0001d4af\u0166\u00da\u219f\u2949\u040e\u016c\u00db\u0423\u0170\U0001d518" +
+	"\u00d9\u016a_\u23df\u23b5\u23dd\u22c3\u228e\u0172\U0001d54c\u2191\u2912\u21c5\u2195\u296e\u22a5\u21a5\u21d1\u21d5\u2196\u2197\u03d2\u03a5\u016e" +
+	"\U0001d4b0\u0168\u00dc\u22ab\u2aeb\u0412\u22a9\u2ae6\u22c1\u2016\u2016\u2223|\u2758\u2240\u200a\U0001d519\U0001d54d\U0001d4b1\u22aa\u0174\u22c0" +
+	"\U0001d51a\U0001d54e\U0001d4b2\U0001d51b\u039e\U0001d54f\U0001d4b3\u042f\u0407\u042e\u00dd\u0176\u042b\U0001d51c\U0001d550\U0001d4b4\u0178\u0416\u0179\u017d\u0417\u017b\u200b" +
+	"\u0396\u2128\u2124\U0001d4b5\u00e1\u0103\u223e\u223e\u0333\u223f\u00e2\u00b4\u0430\u00e6\u2061\U0001d51e\u00e0\u2135\u2135\u03b1\u0101\u2a3f&\u2227\u2a55" +
+	"\u2a5c\u2a58\u2a5a\u2220\u29a4\u2220\u2221\u29a8\u29a9\u29aa\u29ab\u29ac\u29ad\u29ae\u29af\u221f\u22be\u299d\u2222\u00c5\u237c\u0105" +
+	"\U0001d552\u2248\u2a70\u2a6f\u224a\u224b'\u2248\u224a\u00e5\U0001d4b6*\u2248\u224d\u00e3\u00e4\u2233\u2a11\u2aed\u224c\u03f6\u2035\u223d\u22cd" +
+	"\u22bd\u2305\u2305\u23b5\u23b6\u224c\u0431\u201e\u2235\u2235\u29b0\u03f6\u212c\u03b2\u2136\u226c\U0001d51f\u22c2\u25ef\u22c3\u2a00\u2a01" +
+	"\u2a02\u2a06\u2605\u25bd\u25b3\u2a04\u22c1\u22c0\u290d\u29eb\u25aa\u25b4\u25be\u25c2\u25b8\u2423\u2592\u2591\u2593\u2588=\u20e5" +
+	"\u2261\u20e5\u2310\U0001d553\u22a5\u22a5\u22c8\u2557\u2554\u2556\u2553\u2550\u2566\u2569\u2564\u2567\u255d\u255a\u255c\u2559\u2551" +
+	"\u256c\u2563\u2560\u256b\u2562\u255f\u29c9\u2555\u2552\u2510\u250c\u2500\u2565\u2568\u252c\u2534\u229f\u229e\u22a0\u255b\u2558\u2518" +
+	"\u2514\u2502\u256a\u2561\u255e\u253c\u2524\u251c\u2035\u02d8\u00a6\U0001d4b7\u204f\u223d\u22cd\\\u29c5\u27c8\u2022\u2022\u224e\u2aae\u224f" +
+	"\u224f\u0107\u2229\u2a44\u2a49\u2a4b\u2a47\u2a40\u2229\ufe00\u2041\u02c7\u2a4d\u010d\u00e7\u0109\u2a4c\u2a50\u010b\u00b8\u29b2\u00a2\u00b7\U0001d520" +
+	"\u0447\u2713\u2713\u03c7\u25cb\u29c3\u02c6\u2257\u21ba\u21bb\u00ae\u24c8\u229b\u229a\u229d\u2257\u2a10\u2aef\u29c2\u2663\u2663:\u2254\u2254" +
+	",@\u2201\u2218\u2201\u2102\u2245\u2a6d\u222e\U0001d554\u2210\u00a9\u2117\u21b5\u2717\U0001d4b8\u2acf\u2ad1\u2ad0\u2ad2\u22ef\u2938\u2935" +
+	"\u22de\u22df\u21b6\u293d\u222a\u2a48\u2a46\u2a4a\u228d\u2a45\u222a\ufe00\u21b7\u293c\u22de\u22df\u22ce\u22cf\u00a4\u21b6\u21b7\u22ce" +
+	"\u22cf\u2232\u2231\u232d\u21d3\u2965\u2020\u2138\u2193\u2010\u22a3\u290f\u02dd\u010f\u0434\u2146\u2021\u21ca\u2a77\u00b0\u03b4\u29b1\u297f" +
+	"\U0001d521\u21c3\u21c2\u22c4\u22c4\u2666\u2666\u00a8\u03dd\u22f2\u00f7\u00f7\u22c7\u22c7\u0452\u231e\u230d$\U0001d555\u02d9\u2250\u2251\u2238\u2214" +
+	"\u22a1\u2306\u2193\u21ca\u21c3\u21c2\u2910\u231f\u230c\U0001d4b9\u0455\u29f6\u0111\u22f1\u25bf\u25be\u21f5\u296f\u29a6\u045f\u27ff\u2a77" +
+	"\u2251\u00e9\u2a6e\u011b\u2256\u00ea\u2255\u044d\u0117\u2147\u2252\U0001d522\u2a9a\u00e8\u2a96\u2a98\u2a99\u23e7\u2113\u2a95\u2a97\u0113\u2205\u2205" +
+	"\u2205\u2003\u2004\u2005\u014b\u2002\u0119\U0001d556\u22d5\u29e3\u2a71\u03b5\u03b5\u03f5\u2256\u2255\u2242\u2a96\u2a95=\u225f\u2261\u2a78\u29e5" +
+	"\u2253\u2971\u212f\u2250\u2242\u03b7\u00f0\u00eb\u20ac!\u2203\u2130\u2147\u2252\u0444\u2640\ufb03\ufb00\ufb04\U0001d523\ufb01fj\u266d\ufb02" +
+	"\u25b1\u0192\U0001d557\u2200\u22d4\u2ad9\u2a0d\u00bd\u2153\u00bc\u2155\u2159\u215b\u2154\u2156\u00be\u2157\u215c\u2158\u215a\u215d\u215e\u2044" +
+	"\u2322\U0001d4bb\u2267\u2a8c\u01f5\u03b3\u03dd\u2a86\u011f\u011d\u0433\u0121\u2265\u22db\u2265\u2267\u2a7e\u2a7e\u2aa9\u2a80\u2a82\u2a84\u22db\ufe00" +
+	"\u2a94\U0001d524\u226b\u22d9\u2137\u0453\u2277\u2a92\u2aa5\u2aa4\u2269\u2a8a\u2a8a\u2a88\u2a88\u2269\u22e7\U0001d558`\u210a\u2273\u2a8e" +
+	"\u2a90>\u2aa7\u2a7a\u22d7\u2995\u2a7c\u2a86\u2978\u22d7\u22db\u2a8c\u2277\u2273\u2269\ufe00\u2269\ufe00\u21d4\u200a\u00bd\u210b\u044a" +
+	"\u2194\u2948\u21ad\u210f\u0125\u2665\u2665\u2026\u22b9\U0001d525\u2925\u2926\u21ff\u223b\u21a9\u21aa\U0001d559\u2015\U0001d4bd\u210f\u0127" +
+	"\u2043\u2010\u00ed\u2063\u00ee\u0438\u0435\u00a1\u21d4\U0001d526\u00ec\u2148\u2a0c\u222d\u29dc\u2129\u0133\u012b\u2111\u2110\u2111\u0131\u22b7\u01b5\u2208" +
+	"\u2105\u221e\u29dd\u0131\u222b\u22ba\u2124\u22ba\u2a17\u2a3c\u0451\u012f\U0001d55a\u03b9\u2a3c\u00bf\U0001d4be\u2208\u22f9\u22f5\u22f4\u22f3\u2208" +
+	"\u2062\u0129\u0456\u00ef\u0135\u0439\U0001d527\u0237\U0001d55b\U0001d4bf\u0458\u0454\u03ba\u03f0\u0137\u043a\U0001d528\u0138\u0445\u045c\U0001d55c\U0001d4c0\u21da\u21d0\u291b" +
+	"\u290e\u2266\u2a8b\u2962\u013a\u29b4\u2112\u03bb\u27e8\u2991\u27e8\u2a85\u00ab\u2190\u21e4\u291f\u291d\u21a9\u21ab\u2939\u2973\u21a2\u2aab" +
+	"\u2919\u2aad\u2aad\ufe00\u290c\u2772{[\u298b\u298f\u298d\u013e\u013c\u2308{\u043b\u2936\u201c\u201e\u2967\u294b\u21b2\u2264\u2190\u21a2" +
+	"\u21bd\u21bc\u21c7\u2194\u21c6\u21cb\u21ad\u22cb\u22da\u2264\u2266\u2a7d\u2a7d\u2aa8\u2a7f\u2a81\u2a83\u22da\ufe00\u2a93\u2a85\u22d6" +
+	"\u22da\u2a8b\u2276\u2272\u297c\u230a\U0001d529\u2276\u2a91\u21bd\u21bc\u296a\u2584\u0459\u226a\u21c7\u231e\u296b\u25fa\u0140\u23b0\u23b0" +
+	"\u2268\u2a89\u2a89\u2a87\u2a87\u2268\u22e6\u27ec\u21fd\u27e6\u27f5\u27f7\u27fc\u27f6\u21ab\u21ac\u2985\U0001d55d\u2a2d\u2a34\u2217" +
+	"_\u25ca\u25ca\u29eb(\u2993\u21c6\u231f\u21cb\u296d\u200e\u22bf\u2039\U0001d4c1\u21b0\u2272\u2a8d\u2a8f[\u2018\u201a\u0142<\u2aa6" +
+	"\u2a79\u22d6\u22cb\u22c9\u2976\u2a7b\u2996\u25c3\u22b4\u25c2\u294a\u2966\u2268\ufe00\u2268\ufe00\u223a\u00af\u2642\u2720\u2720\u21a6" +
+	"\u21a6\u21a7\u21a4\u21a5\u25ae\u2a29\u043c\u2014\u2221\U0001d52a\u2127\u00b5\u2223*\u2af0\u00b7\u2212\u229f\u2238\u2a2a\u2adb\u2026\u2213" +
+	"\u22a7\U0001d55e\u2213\U0001d4c2\u223e\u03bc\u22b8\u22b8\u22d9\u0338\u226b\u20d2\u226b\u0338\u21cd\u21ce\u22d8\u0338\u226a\u20d2\u226a\u0338\u21cf" +
+	"\u22af\u22ae\u2207\u0144\u2220\u20d2\u2249\u2a70\u0338\u224b\u0338\u0149\u2249\u266e\u266e\u2115\u00a0\u224e\u0338\u224f\u0338\u2a43\u0148\u0146\u2247" +
+	"\u2a6d\u0338\u2a42\u043d\u2013\u2260\u21d7\u2924\u2197\u2197\u2250\u0338\u2262\u2928\u2242\u0338\u2204\u2204\U0001d52b\u2267\u0338\u2271\u2271" +
+	"\u2267\u0338\u2a7e\u0338\u2a7e\u0338\u2275\u226f\u226f\u21ce\u21ae\u2af2\u220b\u22fc\u22fa\u220b\u045a\u21cd\u2266\u0338\u219a\u2025\u2270" +
+	"\u219a\u21ae\u2270\u2266\u0338\u2a7d\u0338\u2a7d\u0338\u226e\u2274\u226e\u22ea\u22ec\u2224\U0001d55f\u00ac\u2209\u22f9\u0338\u22f5\u0338\u2209" +
+	"\u22f7\u22f6\u220c\u220c\u22fe\u22fd\u2226\u2226\u2afd\u20e5\u2202\u0338\u2a14\u2280\u22e0\u2aaf\u0338\u2280\u2aaf\u0338\u21cf\u219b\u2933" +
+	"\u0338\u219d\u0338\u219b\u22eb\u22ed\u2281\u22e1\u2ab0\u0338\U0001d4c3\u2224\u2226\u2241\u2244\u2244\u2224\u2226\u22e2\u22e3\u2284\u2ac5" +
+	"\u0338\u2288\u2282\u20d2\u2288\u2ac5\u0338\u2281\u2ab0\u0338\u2285\u2ac6\u0338\u2289\u2283\u20d2\u2289\u2ac6\u0338\u2279\u00f1\u2278\u22ea\u22ec" +
+	"\u22eb\u22ed\u03bd#\u2116\u2007\u22ad\u2904\u224d\u20d2\u22ac\u2265\u20d2>\u20d2\u29de\u2902\u2264\u20d2<\u20d2\u22b4\u20d2\u2903" +
+	"\u22b5\u20d2\u223c\u20d2\u21d6\u2923\u2196\u2196\u2927\u24c8\u00f3\u229b\u229a\u00f4\u043e\u229d\u0151\u2a38\u2299\u29bc\u0153\u29bf\U0001d52c" +
+	"\u02db\u00f2\u29c1\u29b5\u03a9\u222e\u21ba\u29be\u29bb\u203e\u29c0\u014d\u03c9\u03bf\u29b6\u2296\U0001d560\u29b7\u29b9\u2295\u2228\u21bb\u2a5d" +
+	"\u2134\u2134\u00aa\u00ba\u22b6\u2a56\u2a57\u2a5b\u2134\u00f8\u2298\u00f5\u2297\u2a36\u00f6\u233d\u2225\u00b6\u2225\u2af3\u2afd\u2202\u043f%." +
+	"\u2030\u22a5\u2031\U0001d52d\u03c6\u03d5\u2133\u260e\u03c0\u22d4\u03d6\u210f\u210e\u210f+\u2a23\u229e\u2a22\u2214\u2a25\u2a72\u00b1\u2a26\u2a27" +
+	"\u00b1\u2a15\U0001d561\u00a3\u227a\u2ab3\u2ab7\u227c\u2aaf\u227a\u2ab7\u227c\u2aaf\u2ab9\u2ab5\u22e8\u227e\u2032\u2119\u2ab5\u2ab9\u22e8" +
+	"\u220f\u232e\u2312\u2313\u221d\u221d\u227e\u22b0\U0001d4c5\u03c8\u2008\U0001d52e\u2a0c\U0001d562\u2057\U0001d4c6\u210d\u2a16?\u225f\"\u21db" +
+	"\u21d2\u291c\u290f\u2964\u223d\u0331\u0155\u221a\u29b3\u27e9\u2992\u29a5\u27e9\u00bb\u2192\u2975\u21e5\u2920\u2933\u291e\u21aa\u21ac\u2945" +
+	"\u2974\u21a3\u219d\u291a\u2236\u211a\u290d\u2773}]\u298c\u298e\u2990\u0159\u0157\u2309}\u0440\u2937\u2969\u201d\u201d\u21b3\u211c\u211b" +
+	"\u211c\u211d\u25ad\u00ae\u297d\u230b\U0001d52f\u21c1\u21c0\u296c\u03c1\u03f1\u2192\u21a3\u21c1\u21c0\u21c4\u21cc\u21c9\u219d\u22cc\u02da\u2253" +
+	"\u21c4\u21cc\u200f\u23b1\u23b1\u2aee\u27ed\u21fe\u27e7\u2986\U0001d563\u2a2e\u2a35)\u2994\u2a12\u21c9\u203a\U0001d4c7\u21b1]\u2019" +
+	"\u2019\u22cc\u22ca\u25b9\u22b5\u25b8\u29ce\u2968\u211e\u015b\u201a\u227b\u2ab4\u2ab8\u0161\u227d\u2ab0\u015f\u015d\u2ab6\u2aba\u22e9\u2a13" +
+	"\u227f\u0441\u22c5\u22a1\u2a66\u21d8\u2925\u2198\u2198\u00a7;\u2929\u2216\u2216\u2736\U0001d530\u2322\u266f\u0449\u0448\u2223\u2225\u00ad\u03c3" +
+	"\u03c2\u03c2\u223c\u2a6a\u2243\u2243\u2a9e\u2aa0\u2a9d\u2a9f\u2246\u2a24\u2972\u2190\u2216\u2a33\u29e4\u2223\u2323\u2aaa\u2aac\u2aac" +
+	"\ufe00\u044c/\u29c4\u233f\U0001d564\u2660\u2660\u2225\u2293\u2293\ufe00\u2294\u2294\ufe00\u228f\u2291\u228f\u2291\u2290\u2292\u2290" +
+	"\u2292\u25a1\u25a1\u25aa\u25aa\u2192\U0001d4c8\u2216\u2323\u22c6\u2606\u2605\u03f5\u03d5\u00af\u2282\u2ac5\u2abd\u2286\u2ac3\u2ac1\u2acb" +
+	"\u228a\u2abf\u2979\u2282\u2286\u2ac5\u228a\u2acb\u2ac7\u2ad5\u2ad3\u227b\u2ab8\u227d\u2ab0\u2aba\u2ab6\u22e9\u227f\u2211\u266a\u2283" +
+	"\u00b9\u00b2\u00b3\u2ac6\u2abe\u2ad8\u2287\u2ac4\u27c9\u2ad7\u297b\u2ac2\u2acc\u228b\u2ac0\u2283\u2287\u2ac6\u228b\u2acc\u2ac8\u2ad4\u2ad6" +
+	"\u21d9\u2926\u2199\u2199\u292a\u00df\u2316\u03c4\u23b4\u0165\u0163\u0442\u20db\u2315\U0001d531\u2234\u2234\u03b8\u03d1\u03d1\u2248\u223c\u2009\u2248" +
+	"\u223c\u00fe\u02dc\u00d7\u22a0\u2a31\u2a30\u222d\u2928\u22a4\u2336\u2af1\U0001d565\u2ada\u2929\u2034\u2122\u25b5\u25bf\u25c3\u22b4\u225c" +
+	"\u25b9\u22b5\u25ec\u225c\u2a3a\u2a39\u29cd\u2a3b\u23e2\U0001d4c9\u0446\u045b\u0167\u226c\u219e\u21a0\u21d1\u2963\u00fa\u2191\u045e\u016d\u00fb\u0443" +
+	"\u21c5\u0171\u296e\u297e\U0001d532\u00f9\u21bf\u21be\u2580\u231c\u231c\u230f\u25f8\u016b\u00a8\u0173\U0001d566\u2191\u2195\u21bf\u21be\u228e\u03c5" +
+	"\u03d2\u03c5\u21c8\u231d\u231d\u230e\u016f\u25f9\U0001d4ca\u22f0\u0169\u25b5\u25b4\u21c8\u00fc\u29a7\u21d5\u2ae8\u2ae9\u22a8\u299c\u03f5\u03f0\u2205" +
+	"\u03d5\u03d6\u221d\u2195\u03f1\u03c2\u228a\ufe00\u2acb\ufe00\u228b\ufe00\u2acc\ufe00\u03d1\u22b2\u22b3\u0432\u22a2\u2228\u22bb\u225a\u22ee|" +
+	"|\U0001d533\u22b2\u2282\u20d2\u2283\u20d2\U0001d567\u221d\u22b3\U0001d4cb\u2acb\ufe00\u228a\ufe00\u2acc\ufe00\u228b\ufe00\u299a\u0175\u2a5f" +
+	"\u2227\u2259\u2118\U0001d534\U0001d568\u2118\u2240\u2240\U0001d4cc\u22c2\u25ef\u22c3\u25bd\U0001d535\u27fa\u27f7\u03be\u27f8\u27f5\u27fc\u22fb" +
+	"\u2a00\U0001d569\u2a01\u2a02\u27f9\u27f6\U0001d4cd\u2a06\u2a04\u25b3\u22c1\u22c0\u00fd\u044f\u0177\u044b\u00a5\U0001d536\u0457\U0001d56a\U0001d4ce\u044e" +
+	"\u00ff\u017a\u017e\u0437\u017c\u2128\u03b6\U0001d537\u0436\u21dd\U0001d56b\U0001d4cf\u200d\u200c"
+
+// entityOffsets holds start offsets of each name and value, followed by the end offsets
+var entityOffsets = [...][2]uint16{
+	{0, 0}, {5, 2}, {8, 3}, {14, 5}, {20, 7}, {25, 9}, {28, 11}, {31, 15},
+	{37, 17}, {42, 19}, {47, 21}, {50, 24}, {55, 26}, {59, 30}, {72, 33}, {77, 35},
+	{81, 39}, {87, 42}, {93, 44}, {97, 46}, {106, 49}, {110, 52}, {116, 55}, {119, 57},
+	{126, 60}, {136, 63}, {140, 65}, {143, 69}, {147, 73}, {152, 75}, {156, 78}, {162, 81},
+	{166, 83}, {170, 85}, {176, 87}, {179, 90}, {199, 93}, {206, 96}, {212, 98}, {218, 100},
+	{223, 102}, {230, 105}, {234, 107}, {241, 109}, {250, 111}, {253, 114}, {256, 116}, {265, 119},
+	{276, 122}, {286, 125}, {297, 128}, {321, 131}, {342, 134}, {357, 137}, {362, 140}, {368, 143},
+	{377, 146}, {383, 149}, {398, 152}, {402, 155}, {411, 158}, {442, 161}, {447, 164}, {451, 168},
+	{454, 171}, {460, 174}, {462, 177}, {470, 180}, {474, 182}, {478, 184}, {482, 186}, {488, 189},
+	{492, 192}, {497, 195}, {503, 197}, {506, 199}, {509, 202}, {514, 204}, {517, 208}, {533, 210},
+	{547, 212}, {569, 214}, {585, 215}, {601, 217}, {608, 220}, {621, 223}, {625, 227}, {628, 229},
+	{634, 232}, {642, 235}, {663, 238}, {672, 240}, {687, 243}, {702, 246}, {722, 249}, {735, 252},
+	{754, 255}, {778, 258}, {798, 261}, {814, 264}, {828, 267}, {841, 270}, {858, 273}, {875, 276},
+	{884, 279}, {896, 282}, {912, 285}, {921, 287}, {940, 290}, {957, 293}, {971, 296}, {988, 299},
+	{1006, 302}, {1021, 305}, {1039, 308}, {1046, 311}, {1058, 314}, {1067, 317}, {1071, 321}, {1077, 323},
+	{1080, 325}, {1083, 327}, {1089, 329}, {1095, 331}, {1100, 333}, {1103, 335}, {1107, 337}, {1110, 341},
+	{1116, 343}, {1123, 346}, {1128, 348}, {1144, 351}, {1164, 354}, {1169, 356}, {1173, 360}, {1180, 362},
+	{1185, 365}, {1195, 368}, {1206, 371}, {1210, 374}, {1214, 377}, {1217, 379}, {1221, 381}, {1227, 384},
+	{1239, 387}, {1242, 389}, {1245, 393}, {1262, 396}, {1283, 399}, {1287, 403}, {1293, 406}, {1303, 409},
+	{1307, 412}, {1311, 414}, {1313, 415}, {1318, 417}, {1324, 419}, {1330, 421}, {1336, 423}, {1341, 425},
+	{1344, 427}, {1348, 429}, {1351, 433}, {1353, 436}, {1357, 440}, {1369, 443}, {1385, 446}, {1401, 449},
+	{1415, 452}, {1426, 455}, {1443, 458}, {1455, 461}, {1459, 465}, {1461, 468}, {1467, 470}, {1472, 472},
+	{1475, 473}, {1480, 475}, {1483, 478}, {1495, 481}, {1499, 484}, {1513, 487}, {1517, 490}, {1523, 492},
+	{1535, 495}, {1544, 498}, {1548, 500}, {1553, 502}, {1557, 504}, {1563, 506}, {1568, 508}, {1571, 510},
+	{1575, 512}, {1578, 515}, {1584, 517}, {1586, 520}, {1591, 522}, {1601, 525}, {1608, 528}, {1611, 531},
+	{1619, 534}, {1631, 537}, {1645, 540}, {1659, 543}, {1664, 545}, {1668, 549}, {1672, 551}, {1676, 554},
+	{1682, 556}, {1687, 558}, {1691, 560}, {1696, 562}, {1699, 564}, {1702, 568}, {1706, 572}, {1710, 576},
+	{1716, 578}, {1721, 580}, {1725, 582}, {1729, 584}, {1734, 586}, {1740, 588}, {1743, 590}, {1746, 594},
+	{1750, 598}, {1754, 602}, {1758, 604}, {1760, 605}, {1766, 607}, {1772, 609}, {1776, 612}, {1786, 615},
+	{1790, 618}, {1796, 620}, {1802, 622}, {1805, 624}, {1821, 627}, {1830, 630}, {1842, 633}, {1861, 636},
+	{1872, 639}, {1889, 642}, {1906, 645}, {1920, 648}, {1937, 651}, {1946, 654}, {1960, 657}, {1975, 660},
+	{1982, 663}, {1994, 666}, {2007, 669}, {2019, 672}, {2034, 675}, {2051, 678}, {2067, 681}, {2082, 684},
+	{2094, 687}, {2109, 690}, {2119, 693}, {2132, 696}, {2141, 699}, {2155, 702}, {2171, 705}, {2184, 708},
+	{2195, 711}, {2203, 714}, {2217, 717}, {2226, 720}, {2229, 724}, {2231, 727}, {2241, 730}, {2247, 732},
+	{2260, 735}, {2278, 738}, {2292, 741}, {2305, 744}, {2323, 747}, {2337, 750}, {2341, 754}, {2355, 757},
+	{2370, 760}, {2374, 763}, {2377, 766}, {2383, 768}, {2385, 771}, {2388, 774}, {2391, 776}, {2402, 779},
+	{2411, 782}, {2414, 786}, {2423, 789}, {2427, 793}, {2431, 796}, {2433, 798}, {2437, 800}, {2443, 802},
+	{2449, 804}, {2455, 806}, {2458, 808}, {2477, 811}, {2495, 814}, {2512, 817}, {2533, 820}, {2553, 823},
+	{2567, 826}, {2574, 827}, {2577, 831}, {2584, 834}, {2600, 836}, {2604, 839}, {2607, 842}, {2619, 845},
+	{2628, 848}, {2648, 851}, {2658, 854}, {2666, 857}, {2679, 862}, {2688, 865}, {2698, 868}, {2713, 871},
+	{2732, 876}, {2749, 881}, {2763, 884}, {2783, 889}, {2798, 892}, {2813, 897}, {2825, 902}, {2840, 905},
+	{2858, 910}, {2878, 913}, {2885, 916}, {2897, 919}, {2911, 922}, {2922, 927}, {2939, 932}, {2951, 935},
+	{2974, 940}, {2991, 945}, {3002, 948}, {3018, 953}, {3039, 956}, {3056, 959}, {3072, 962}, {3091, 967},
+	{3112, 970}, {3127, 975}, {3147, 978}, {3164, 983}, {3186, 986}, {3195, 992}, {3209, 995}, {3220, 998},
+	{3236, 1003}, {3257, 1006}, {3273, 1011}, {3284, 1017}, {3300, 1020}, {3308, 1023}, {3321, 1026}, {3338, 1029},
+	{3351, 1032}, {3365, 1035}, {3369, 1039}, {3375, 1041}, {3377, 1043}, {3382, 1045}, {3388, 1047}, {3393, 1049},
+	{3396, 1051}, {3402, 1053}, {3405, 1057}, {3411, 1059}, {3416, 1061}, {3421, 1063}, {3428, 1065}, {3432, 1069},
+	{3452, 1072}, {3466, 1075}, {3468, 1078}, {3472, 1082}, {3478, 1084}, {3484, 1086}, {3490, 1089}, {3494, 1091},
+	{3501, 1094}, {3510, 1097}, {3521, 1100}, {3536, 1103}, {3544, 1106}, {3547, 1108}, {3550, 1112}, {3553, 1114},
+	{3555, 1116}, {3564, 1118}, {3577, 1121}, {3581, 1124}, {3583, 1127}, {3591, 1130}, {3604, 1133}, {3622, 1136},
+	{3635, 1139}, {3640, 1142}, {3647, 1145}, {3657, 1148}, {3669, 1151}, {3673, 1155}, {3676, 1157}, {3680, 1158},
+	{3683, 1162}, {3687, 1165}, {3691, 1169}, {3696, 1172}, {3699, 1174}, {3705, 1176}, {3709, 1179}, {3713, 1182},
+	{3719, 1185}, {3725, 1187}, {3731, 1189}, {3734, 1191}, {3736, 1194}, {3750, 1197}, {3768, 1200}, {3788, 1203},
+	{3791, 1206}, {3794, 1208}, {3811, 1211}, {3821, 1214}, {3834, 1217}, {3853, 1220}, {3865, 1223}, {3883, 1226},
+	{3901, 1229}, {3916, 1232}, {3934, 1235}, {3944, 1238}, {3952, 1241}, {3965, 1244}, {3979, 1247}, {3992, 1250},
+	{4008, 1253}, {4026, 1256}, {4043, 1259}, {4059, 1262}, {4072, 1265}, {4088, 1268}, {4099, 1271}, {4113, 1274},
+	{4123, 1277}, {4127, 1280}, {4139, 1283}, {4150, 1286}, {4154, 1289}, {4157, 1292}, {4168, 1295}, {4174, 1297},
+	{4178, 1299}, {4184, 1301}, {4190, 1303}, {4192, 1306}, {4198, 1308}, {4204, 1310}, {4209, 1312}, {4212, 1314},
+	{4215, 1318}, {4229, 1321}, {4243, 1324}, {4258, 1327}, {4270, 1330}, {4275, 1332}, {4286, 1335}, {4290, 1339},
+	{4294, 1342}, {4300, 1345}, {4318, 1348}, {4330, 1351}, {4347, 1354}, {4361, 1357}, {4380, 1360}, {4391, 1363},
+	{4395, 1367}, {4399, 1370}, {4402, 1373}, {4408, 1376}, {4419, 1379}, {4427, 1382}, {4440, 1385}, {4458, 1388},
+	{4471, 1391}, {4479, 1394}, {4482, 1397}, {4485, 1400}, {4493, 1403}, {4506, 1406}, {4512, 1409}, {4517, 1411},
+	{4522, 1414}, {4527, 1416}, {4531, 1418}, {4534, 1419}, {4537, 1421}, {4543, 1423}, {4549, 1425}, {4552, 1427},
+	{4555, 1431}, {4564, 1434}, {4569, 1436}, {4579, 1442}, {4588, 1445}, {4593, 1448}, {4603, 1451}, {4617, 1454},
+	{4627, 1457}, {4631, 1461}, {4640, 1464}, {4644, 1468}, {4650, 1470}, {4656, 1472}, {4660, 1475}, {4668, 1478},
+	{4673, 1480}, {4679, 1482}, {4684, 1484}, {4687, 1486}, {4693, 1488}, {4696, 1492}, {4702, 1494}, {4707, 1496},
+	{4715, 1497}, {4725, 1500}, {4737, 1503}, {4753, 1506}, {4758, 1509}, {4767, 1512}, {4772, 1514}, {4776, 1518},
+	{4783, 1521}, {4793, 1524}, {4809, 1527}, {4820, 1530}, {4833, 1533}, {4838, 1536}, {4848, 1539}, {4855, 1542},
+	{4866, 1545}, {4880, 1548}, {4895, 1551}, {4899, 1553}, {4906, 1555}, {4911, 1557}, {4915, 1561}, {4921, 1563},
+	{4925, 1565}, {4930, 1568}, {4934, 1571}, {4937, 1573}, {4942, 1576}, {4948, 1579}, {4951, 1582}, {4957, 1585},
+	{4961, 1588}, {4972, 1591}, {4984, 1592}, {5001, 1595}, {5014, 1598}, {5027, 1601}, {5030, 1605}, {5034, 1609},
+	{5038, 1613}, {5044, 1616}, {5049, 1618}, {5054, 1621}, {5057, 1625}, {5061, 1629}, {5065, 1633}, {5068, 1637},
+	{5070, 1639}, {5074, 1643}, {5078, 1647}, {5082, 1649}, {5086, 1651}, {5090, 1653}, {5096, 1655}, {5101, 1657},
+	{5104, 1659}, {5107, 1663}, {5111, 1667}, {5115, 1671}, {5119, 1673}, {5123, 1675}, {5129, 1677}, {5135, 1679},
+	{5138, 1681}, {5142, 1683}, {5156, 1686}, {5160, 1688}, {5163, 1691}, {5167, 1694}, {5171, 1698}, {5177, 1700},
+	{5183, 1702}, {5185, 1705}, {5188, 1710}, {5191, 1713}, {5196, 1715}, {5201, 1717}, {5204, 1719}, {5209, 1721},
+	{5211, 1724}, {5214, 1728}, {5220, 1730}, {5227, 1733}, {5232, 1736}, {5237, 1738}, {5242, 1740}, {5247, 1743},
+	{5250, 1744}, {5253, 1747}, {5259, 1750}, {5263, 1753}, {5271, 1756}, {5275, 1759}, {5278, 1762}, {5282, 1765},
+	{5287, 1768}, {5293, 1771}, {5301, 1774}, {5309, 1777}, {5317, 1780}, {5325, 1783}, {5333, 1786}, {5341, 1789},
+	{5349, 1792}, {5357, 1795}, {5362, 1798}, {5369, 1801}, {5377, 1804}, {5383, 1807}, {5388, 1809}, {5395, 1812},
+	{5400, 1814}, {5404, 1818}, {5406, 1821}, {5409, 1824}, {5415, 1827}, {5418, 1830}, {5422, 1833}, {5426, 1834},
+	{5432, 1837}, {5440, 1840}, {5445, 1842}, {5449, 1846}, {5452, 1847}, {5457, 1850}, {5464, 1853}, {5470, 1855},
+	{5474, 1857}, {5482, 1860}, {5487, 1863}, {5491, 1866}, {5499, 1869}, {5510, 1871}, {5519, 1874}, {5526, 1877},
+	{5535, 1880}, {5541, 1883}, {5547, 1886}, {5555, 1889}, {5559, 1892}, {5567, 1895}, {5572, 1898}, {5575, 1900},
+	{5580, 1903}, {5586, 1906}, {5593, 1909}, {5600, 1912}, {5605, 1914}, {5611, 1917}, {5615, 1919}, {5619, 1922},
+	{5626, 1925}, {5629, 1929}, {5635, 1932}, {5642, 1935}, {5648, 1938}, {5655, 1941}, {5663, 1944}, {5672, 1947},
+	{5680, 1950}, {5687, 1953}, {5702, 1956}, {5715, 1959}, {5723, 1962}, {5729, 1965}, {5737, 1968}, {5743, 1971},
+	{5755, 1974}, {5766, 1977}, {5779, 1980}, {5796, 1983}, {5813, 1986}, {5831, 1989}, {5836, 1992}, {5841, 1995},
+	{5846, 1998}, {5851, 2001}, {5856, 2004}, {5859, 2008}, {5866, 2014}, {5870, 2017}, {5874, 2021}, {5877, 2024},
+	{5883, 2027}, {5889, 2030}, {5894, 2033}, {5899, 2036}, {5904, 2039}, {5909, 2042}, {5913, 2045}, {5918, 2048},
+	{5923, 2051}, {5928, 2054}, {5933, 2057}, {5938, 2060}, {5943, 2063}, {5948, 2066}, {5953, 2069}, {5957, 2072},
+	{5962, 2075}, {5967, 2078}, {5972, 2081}, {5977, 2084}, {5982, 2087}, {5987, 2090}, {5993, 2093}, {5998, 2096},
+	{6003, 2099}, {6008, 2102}, {6013, 2105}, {6017, 2108}, {6022, 2111}, {6027, 2114}, {6032, 2117}, {6037, 2120},
+	{6045, 2123}, {6052, 2126}, {6060, 2129}, {6065, 2132}, {6070, 2135}, {6075, 2138}, {6080, 2141}, {6084, 2144},
+	{6089, 2147}, {6094, 2150}, {6099, 2153}, {6104, 2156}, {6109, 2159}, {6114, 2162}, {6120, 2165}, {6125, 2167},
+	{6131, 2169}, {6135, 2173}, {6140, 2176}, {6144, 2179}, {6149, 2182}, {6153, 2183}, {6158, 2186}, {6166, 2189},
+	{6170, 2192}, {6176, 2195}, {6180, 2198}, {6185, 2201}, {6190, 2204}, {6196, 2207}, {6202, 2209}, {6205, 2212},
+	{6211, 2215}, {6219, 2218}, {6225, 2221}, {6231, 2224}, {6237, 2227}, {6241, 2233}, {6246, 2236}, {6251, 2238},
+	{6256, 2241}, {6262, 2243}, {6268, 2245}, {6273, 2247}, {6278, 2250}, {6285, 2253}, {6289, 2255}, {6294, 2257},
+	{6301, 2260}, {6305, 2262}, {6314, 2264}, {6317, 2268}, {6321, 2270}, {6326, 2273}, {6335, 2276}, {6338, 2278},
+	{6341, 2281}, {6345, 2284}, {6349, 2286}, {6355, 2289}, {6370, 2292}, {6386, 2295}, {6394, 2297}, {6402, 2300},
+	{6412, 2303}, {6423, 2306}, {6434, 2309}, {6438, 2312}, {6446, 2315}, {6452, 2318}, {6459, 2321}, {6464, 2324},
+	{6472, 2327}, {6477, 2328}, {6483, 2331}, {6490, 2334}, {6495, 2335}, {6501, 2336}, {6505, 2339}, {6511, 2342},
+	{6521, 2345}, {6530, 2348}, {6534, 2351}, {6541, 2354}, {6547, 2357}, {6551, 2361}, {6557, 2364}, {6561, 2366},
+	{6567, 2369}, {6572, 2372}, {6577, 2375}, {6581, 2379}, {6585, 2382}, {6590, 2385}, {6594, 2388}, {6599, 2391},
+	{6604, 2394}, {6611, 2397}, {6618, 2400}, {6623, 2403}, {6628, 2406}, {6634, 2409}, {6641, 2412}, {6644, 2415},
+	{6652, 2418}, {6658, 2421}, {6664, 2424}, {6670, 2427}, {6675, 2430}, {6679, 2436}, {6685, 2439}, {6692, 2442},
+	{6703, 2445}, {6714, 2448}, {6722, 2451}, {6732, 2454}, {6738, 2456}, {6752, 2459}, {6767, 2462}, {6772, 2465},
+	{6777, 2468}, {6785, 2471}, {6790, 2474}, {6796, 2477}, {6800, 2480}, {6804, 2483}, {6810, 2486}, {6816, 2489},
+	{6820, 2492}, {6824, 2495}, {6829, 2498}, {6836, 2501}, {6841, 2503}, {6847, 2505}, {6850, 2507}, {6852, 2510},
+	{6859, 2513}, {6864, 2516}, {6871, 2519}, {6874, 2521}, {6879, 2523}, {6886, 2526}, {6892, 2529}, {6895, 2533},
+	{6900, 2536}, {6905, 2539}, {6909, 2542}, {6916, 2545}, {6927, 2548}, {6932, 2551}, {6935, 2553}, {6942, 2555},
+	{6947, 2558}, {6950, 2560}, {6956, 2562}, {6969, 2565}, {6975, 2568}, {6979, 2570}, {6985, 2573}, {6991, 2576},
+	{6997, 2577}, {7001, 2581}, {7004, 2583}, {7009, 2586}, {7017, 2589}, {7025, 2592}, {7032, 2595}, {7041, 2598},
+	{7055, 2601}, {7064, 2604}, {7078, 2607}, {7093, 2610}, {7109, 2613}, {7117, 2616}, {7123, 2619}, {7129, 2622},
+	{7133, 2626}, {7137, 2628}, {7141, 2631}, {7147, 2633}, {7152, 2636}, {7156, 2639}, {7161, 2642}, {7166, 2645},
+	{7171, 2648}, {7178, 2651}, {7182, 2653}, {7190, 2656}, {7195, 2659}, {7199, 2662}, {7205, 2664}, {7211, 2667},
+	{7217, 2669}, {7221, 2672}, {7226, 2674}, {7232, 2677}, {7235, 2679}, {7239, 2681}, {7241, 2684}, {7246, 2687},
+	{7249, 2691}, {7251, 2694}, {7257, 2696}, {7260, 2699}, {7266, 2702}, {7268, 2705}, {7276, 2708}, {7279, 2711},
+	{7282, 2714}, {7288, 2717}, {7293, 2719}, {7298, 2722}, {7306, 2725}, {7312, 2728}, {7316, 2731}, {7322, 2734},
+	{7328, 2737}, {7331, 2739}, {7335, 2742}, {7340, 2744}, {7344, 2748}, {7348, 2751}, {7354, 2754}, {7359, 2757},
+	{7363, 2759}, {7370, 2761}, {7375, 2763}, {7381, 2766}, {7388, 2769}, {7393, 2772}, {7403, 2775}, {7414, 2778},
+	{7420, 2779}, {7426, 2782}, {7431, 2785}, {7438, 2788}, {7446, 2791}, {7451, 2794}, {7456, 2797}, {7460, 2800},
+	{7465, 2803}, {7469, 2806}, {7472, 2808}, {7475, 2810}, {7479, 2812}, {7483, 2815}, {7487, 2816}, {7492, 2819},
+	{7503, 2822}, {7515, 2825}, {7528, 2828}, {7531, 2830}, {7537, 2833}, {7543, 2836}, {7548, 2839}, {7554, 2842},
+	{7557, 2846}, {7562, 2849}, {7567, 2851}, {7571, 2854}, {7576, 2857}, {7581, 2860}, {7585, 2862}, {7589, 2866},
+	{7595, 2869}, {7599, 2872}, {7604, 2875}, {7612, 2878}, {7618, 2880}, {7624, 2883}, {7630, 2885}, {7636, 2888},
+	{7642, 2891}, {7648, 2894}, {7654, 2897}, {7660, 2900}, {7666, 2902}, {7672, 2905}, {7678, 2908}, {7684, 2911},
+	{7690, 2914}, {7696, 2917}, {7702, 2920}, {7707, 2923}, {7712, 2926}, {7716, 2930}, {7718, 2933}, {7721, 2936},
+	{7727, 2938}, {7732, 2940}, {7738, 2942}, {7741, 2945}, {7747, 2947}, {7752, 2949}, {7755, 2951}, {7759, 2953},
+	{7761, 2956}, {7764, 2959}, {7767, 2962}, {7771, 2965}, {7779, 2968}, {7782, 2971}, {7787, 2974}, {7793, 2977},
+	{7800, 2980}, {7808, 2983}, {7812, 2989}, {7818, 2992}, {7821, 2996}, {7823, 2999}, {7826, 3002}, {7831, 3005},
+	{7835, 3007}, {7837, 3010}, {7840, 3013}, {7843, 3016}, {7846, 3019}, {7849, 3022}, {7853, 3025}, {7861, 3028},
+	{7864, 3031}, {7868, 3034}, {7873, 3037}, {7878, 3040}, {7882, 3044}, {7887, 3045}, {7891, 3048}, {7895, 3051},
+	{7900, 3054}, {7905, 3057}, {7907, 3058}, {7911, 3061}, {7916, 3064}, {7921, 3067}, {7927, 3070}, {7934, 3073},
+	{7943, 3076}, {7949, 3079}, {7955, 3082}, {7964, 3085}, {7974, 3088}, {7981, 3091}, {7987, 3094}, {7996, 3100},
+	{8000, 3106}, {8004, 3109}, {8010, 3112}, {8014, 3114}, {8020, 3117}, {8026, 3119}, {8030, 3122}, {8037, 3125},
+	{8042, 3128}, {8046, 3131}, {8051, 3133}, {8057, 3136}, {8066, 3139}, {8072, 3142}, {8078, 3145}, {8081, 3149},
+	{8089, 3152}, {8097, 3155}, {8102, 3158}, {8108, 3161}, {8121, 3164}, {8135, 3167}, {8139, 3171}, {8145, 3174},
+	{8149, 3178}, {8155, 3181}, {8161, 3183}, {8167, 3186}, {8173, 3189}, {8179, 3191}, {8181, 3194}, {8186, 3196},
+	{8189, 3198}, {8193, 3200}, {8198, 3202}, {8201, 3205}, {8204, 3209}, {8210, 3211}, {8212, 3214}, {8218, 3217},
+	{8223, 3220}, {8229, 3223}, {8234, 3226}, {8239, 3228}, {8244, 3230}, {8249, 3233}, {8257, 3236}, {8265, 3239},
+	{8270, 3241}, {8274, 3244}, {8279, 3246}, {8281, 3249}, {8287, 3252}, {8292, 3255}, {8300, 3258}, {8306, 3260},
+	{8309, 3263}, {8315, 3266}, {8323, 3269}, {8331, 3272}, {8339, 3275}, {8346, 3278}, {8350, 3280}, {8355, 3282},
+	{8359, 3286}, {8363, 3288}, {8368, 3291}, {8374, 3293}, {8378, 3297}, {8382, 3300}, {8387, 3303}, {8394, 3306},
+	{8399, 3309}, {8405, 3312}, {8410, 3315}, {8412, 3318}, {8418, 3320}, {8423, 3322}, {8427, 3324}, {8432, 3326},
+	{8435, 3328}, {8438, 3332}, {8443, 3334}, {8447, 3338}, {8451, 3342}, {8457, 3344}, {8462, 3346}, {8467, 3348},
+	{8473, 3350}, {8479, 3352}, {8482, 3354}, {8485, 3358}, {8491, 3360}, {8495, 3362}, {8499, 3364}, {8503, 3368},
+	{8507, 3372}, {8512, 3375}, {8516, 3378}, {8522, 3381}, {8527, 3384}, {8529, 3387}, {8532, 3390}, {8536, 3393},
+	{8542, 3395}, {8550, 3398}, {8556, 3401}, {8562, 3403}, {8566, 3406}, {8571, 3409}, {8577, 3412}, {8580, 3415},
+	{8585, 3417}, {8589, 3420}, {8594, 3423}, {8601, 3426}, {8607, 3429}, {8613, 3432}, {8619, 3435}, {8625, 3438},
+	{8632, 3441}, {8638, 3444}, {8641, 3447}, {8647, 3450}, {8651, 3453}, {8656, 3459}, {8661, 3462}, {8666, 3465},
+	{8672, 3466}, {8678, 3467}, {8683, 3470}, {8690, 3473}, {8697, 3476}, {8703, 3478}, {8709, 3480}, {8714, 3483},
+	{8718, 3484}, {8721, 3486}, {8725, 3489}, {8730, 3492}, {8736, 3495}, {8743, 3498}, {8751, 3501}, {8755, 3504},
+	{8757, 3507}, {8766, 3510}, {8779, 3513}, {8794, 3516}, {8807, 3519}, {8821, 3522}, {8835, 3525}, {8850, 3528},
+	{8867, 3531}, {8886, 3534}, {8900, 3537}, {8903, 3540}, {8906, 3543}, {8910, 3546}, {8918, 3549}, {8921, 3552},
+	{8926, 3555}, {8932, 3558}, {8939, 3561}, {8947, 3564}, {8951, 3570}, {8957, 3573}, {8967, 3576}, {8974, 3579},
+	{8983, 3582}, {8993, 3585}, {9000, 3588}, {9007, 3591}, {9013, 3594}, {9019, 3597}, {9022, 3601}, {9024, 3604},
+	{9027, 3607}, {9032, 3610}, {9037, 3613}, {9043, 3616}, {9048, 3619}, {9052, 3621}, {9054, 3624}, {9059, 3627},
+	{9067, 3630}, {9073, 3633}, {9078, 3636}, {9084, 3638}, {9090, 3641}, {9100, 3644}, {9103, 3647}, {9107, 3650},
+	{9115, 3653}, {9118, 3656}, {9122, 3659}, {9127, 3662}, {9132, 3665}, {9137, 3668}, {9142, 3671}, {9147, 3674},
+	{9160, 3677}, {9178, 3680}, {9188, 3683}, {9202, 3686}, {9215, 3689}, {9229, 3692}, {9234, 3695}, {9238, 3699},
+	{9244, 3702}, {9251, 3705}, {9257, 3708}, {9263, 3709}, {9266, 3712}, {9273, 3715}, {9277, 3718}, {9281, 3719},
+	{9287, 3722}, {9292, 3725}, {9300, 3728}, {9305, 3731}, {9311, 3734}, {9314, 3737}, {9319, 3740}, {9325, 3743},
+	{9329, 3747}, {9332, 3750}, {9336, 3753}, {9341, 3756}, {9346, 3759}, {9350, 3760}, {9355, 3763}, {9361, 3766},
+	{9367, 3768}, {9369, 3769}, {9373, 3772}, {9378, 3775}, {9383, 3778}, {9389, 3781}, {9395, 3784}, {9401, 3787},
+	{9408, 3790}, {9414, 3793}, {9418, 3796}, {9423, 3799}, {9428, 3802}, {9436, 3805}, {9443, 3808}, {9452, 3814},
+	{9456, 3820}, {9461, 3823}, {9465, 3825}, {9469, 3828}, {9473, 3831}, {9480, 3834}, {9483, 3837}, {9489, 3840},
+	{9499, 3843}, {9509, 3846}, {9517, 3849}, {9523, 3852}, {9529, 3855}, {9532, 3857}, {9537, 3860}, {9550, 3863},
+	{9553, 3867}, {9556, 3870}, {9561, 3872}, {9564, 3875}, {9570, 3876}, {9576, 3879}, {9582, 3881}, {9587, 3884},
+	{9593, 3887}, {9599, 3890}, {9606, 3893}, {9610, 3896}, {9614, 3899}, {9620, 3902}, {9626, 3905}, {9630, 3909},
+	{9632, 3912}, {9636, 3916}, {9642, 3919}, {9644, 3921}, {9652, 3924}, {9657, 3927}, {9660, 3932}, {9663, 3938},
+	{9667, 3943}, {9677, 3946}, {9692, 3949}, {9695, 3954}, {9698, 3960}, {9702, 3965}, {9713, 3968}, {9719, 3971},
+	{9725, 3974}, {9730, 3977}, {9736, 3979}, {9740, 3985}, {9743, 3988}, {9747, 3993}, {9752, 3998}, {9757, 4000},
+	{9764, 4003}, {9769, 4006}, {9776, 4009}, {9784, 4012}, {9788, 4014}, {9793, 4019}, {9799, 4024}, {9803, 4027},
+	{9809, 4029}, {9815, 4031}, {9820, 4034}, {9828, 4039}, {9832, 4042}, {9835, 4044}, {9840, 4047}, {9842, 4050},
+	{9847, 4053}, {9853, 4056}, {9858, 4059}, {9865, 4062}, {9870, 4067}, {9876, 4070}, {9882, 4073}, {9887, 4078},
+	{9893, 4081}, {9900, 4084}, {9903, 4088}, {9906, 4093}, {9909, 4096}, {9913, 4099}, {9918, 4104}, {9927, 4109},
+	{9931, 4114}, {9936, 4117}, {9939, 4120}, {9943, 4123}, {9948, 4126}, {9953, 4129}, {9958, 4132}, {9960, 4135},
+	{9963, 4138}, {9967, 4141}, {9970, 4144}, {9974, 4146}, {9979, 4149}, {9982, 4154}, {9987, 4157}, {9991, 4160},
+	{9994, 4163}, {10004, 4166}, {10019, 4169}, {10023, 4172}, {10028, 4177}, {10037, 4182}, {10041, 4187}, {10046, 4190},
+	{10051, 4193}, {10054, 4196}, {10059, 4199}, {10065, 4202}, {10069, 4205}, {10073, 4209}, {10076, 4211}, {10081, 4214},
+	{10087, 4219}, {10095, 4224}, {10102, 4227}, {10109, 4230}, {10116, 4233}, {10121, 4236}, {10128, 4239}, {10135, 4242},
+	{10142, 4245}, {10146, 4248}, {10155, 4251}, {10161, 4257}, {10166, 4262}, {10173, 4265}, {10176, 4268}, {10182, 4271},
+	{10186, 4276}, {10191, 4279}, {10198, 4284}, {10203, 4287}, {10208, 4290}, {10214, 4295}, {10220, 4300}, {10231, 4303},
+	{10236, 4306}, {10242, 4309}, {10245, 4312}, {10251, 4315}, {10255, 4320}, {10259, 4324}, {10268, 4327}, {10282, 4330},
+	{10286, 4333}, {10291, 4336}, {10297, 4339}, {10302, 4342}, {10307, 4345}, {10314, 4348}, {10321, 4351}, {10325, 4354},
+	{10330, 4359}, {10335, 4362}, {10342, 4368}, {10351, 4371}, {10361, 4376}, {10366, 4379}, {10373, 4384}, {10377, 4387},
+	{10382, 4392}, {10387, 4395}, {10394, 4401}, {10403, 4404}, {10413, 4409}, {10417, 4412}, {10423, 4414}, {10427, 4417},
+	{10440, 4420}, {10455, 4423}, {10469, 4426}, {10485, 4429}, {10487, 4431}, {10490, 4432}, {10496, 4435}, {10501, 4438},
+	{10507, 4441}, {10513, 4444}, {10517, 4450}, {10523, 4453}, {10527, 4459}, {10531, 4463}, {10538, 4466}, {10544, 4469},
+	{10548, 4475}, {10552, 4479}, {10559, 4485}, {10565, 4488}, {10572, 4494}, {10577, 4500}, {10582, 4503}, {10588, 4506},
+	{10593, 4509}, {10600, 4512}, {10606, 4515}, {10608, 4518}, {10614, 4520}, {10618, 4523}, {10622, 4526}, {10627, 4528},
+	{10630, 4530}, {10635, 4533}, {10641, 4535}, {10645, 4538}, {10649, 4541}, {10655, 4544}, {10660, 4546}, {10665, 4549},
+	{10668, 4553}, {10672, 4555}, {10678, 4557}, {10681, 4560}, {10686, 4563}, {10689, 4565}, {10693, 4568}, {10698, 4571},
+	{10703, 4574}, {10710, 4577}, {10715, 4580}, {10718, 4583}, {10723, 4585}, {10728, 4587}, {10735, 4589}, {10739, 4592},
+	{10745, 4595}, {10749, 4599}, {10753, 4602}, {10758, 4605}, {10763, 4608}, {10765, 4611}, {10770, 4614}, {10773, 4617},
+	{10778, 4620}, {10785, 4623}, {10789, 4625}, {10793, 4627}, {10799, 4630}, {10803, 4633}, {10810, 4636}, {10813, 4639},
+	{10817, 4642}, {10823, 4644}, {10827, 4647}, {10833, 4649}, {10839, 4652}, {10847, 4655}, {10851, 4657}, {10856, 4660},
+	{10859, 4663}, {10863, 4665}, {10871, 4668}, {10877, 4671}, {10882, 4674}, {10886, 4677}, {10889, 4679}, {10895, 4680},
+	{10901, 4681}, {10907, 4684}, {10911, 4687}, {10918, 4690}, {10921, 4694}, {10924, 4696}, {10928, 4698}, {10934, 4701},
+	{10939, 4704}, {10941, 4706}, {10950, 4709}, {10953, 4711}, {10959, 4714}, {10966, 4717}, {10972, 4720}, {10976, 4721},
+	{10984, 4724}, {10989, 4727}, {10996, 4730}, {11002, 4733}, {11008, 4736}, {11013, 4739}, {11019, 4741}, {11026, 4744},
+	{11033, 4747}, {11035, 4749}, {11043, 4752}, {11047, 4756}, {11052, 4758}, {11054, 4761}, {11057, 4764}, {11061, 4767},
+	{11066, 4770}, {11069, 4773}, {11073, 4776}, {11083, 4779}, {11094, 4782}, {11100, 4785}, {11111, 4788}, {11119, 4791},
+	{11127, 4794}, {11134, 4797}, {11139, 4800}, {11145, 4803}, {11149, 4806}, {11154, 4809}, {11160, 4812}, {11164, 4815},
+	{11172, 4818}, {11180, 4821}, {11188, 4824}, {11192, 4827}, {11198, 4830}, {11203, 4833}, {11209, 4836}, {11213, 4840},
+	{11216, 4842}, {11222, 4845}, {11225, 4849}, {11229, 4852}, {11233, 4856}, {11239, 4859}, {11243, 4863}, {11254, 4866},
+	{11261, 4869}, {11266, 4870}, {11273, 4873}, {11277, 4874}, {11282, 4877}, {11286, 4880}, {11292, 4883}, {11297, 4886},
+	{11301, 4889}, {11305, 4894}, {11311, 4896}, {11316, 4899}, {11324, 4902}, {11328, 4905}, {11333, 4908}, {11338, 4911},
+	{11344, 4914}, {11349, 4916}, {11353, 4919}, {11359, 4922}, {11364, 4925}, {11371, 4928}, {11376, 4931}, {11382, 4934},
+	{11388, 4937}, {11394, 4940}, {11400, 4943}, {11407, 4946}, {11413, 4949}, {11418, 4952}, {11424, 4955}, {11429, 4958},
+	{11438, 4961}, {11443, 4964}, {11448, 4967}, {11454, 4968}, {11460, 4969}, {11465, 4972}, {11472, 4975}, {11479, 4978},
+	{11485, 4980}, {11491, 4982}, {11496, 4985}, {11500, 4986}, {11503, 4988}, {11507, 4991}, {11514, 4994}, {11519, 4997},
+	{11525, 5000}, {11529, 5003}, {11533, 5006}, {11540, 5009}, {11548, 5012}, {11553, 5015}, {11557, 5018}, {11560, 5020},
+	{11566, 5023}, {11572, 5026}, {11575, 5030}, {11580, 5033}, {11585, 5036}, {11591, 5039}, {11594, 5041}, {11598, 5043},
+	{11608, 5046}, {11622, 5049}, {11638, 5052}, {11652, 5055}, {11667, 5058}, {11684, 5061}, {11700, 5064}, {11715, 5067},
+	{11730, 5070}, {11734, 5072}, {11746, 5075}, {11751, 5078}, {11756, 5081}, {11759, 5084}, {11765, 5087}, {11775, 5090},
+	{11780, 5093}, {11785, 5096}, {11790, 5099}, {11795, 5102}, {11800, 5105}, {11804, 5109}, {11810, 5112}, {11817, 5115},
+	{11821, 5116}, {11827, 5119}, {11835, 5122}, {11840, 5125}, {11846, 5128}, {11850, 5132}, {11853, 5135}, {11857, 5136},
+	{11862, 5139}, {11868, 5142}, {11874, 5145}, {11880, 5148}, {11884, 5151}, {11889, 5154}, {11894, 5157}, {11902, 5160},
+	{11909, 5163}, {11911, 5166}, {11917, 5168}, {11922, 5171}, {11924, 5174}, {11927, 5177}, {11931, 5180}, {11937, 5182},
+	{11942, 5185}, {11945, 5188}, {11951, 5190}, {11956, 5192}, {11960, 5195}, {11965, 5198}, {11971, 5201}, {11979, 5204},
+	{11984, 5207}, {11987, 5209}, {11991, 5212}, {11996, 5215}, {12001, 5218}, {12006, 5221}, {12012, 5224}, {12017, 5227},
+	{12024, 5230}, {12028, 5232}, {12032, 5233}, {12038, 5236}, {12046, 5239}, {12051, 5242}, {12055, 5245}, {12058, 5249},
+	{12064, 5252}, {12069, 5255}, {12075, 5257}, {12079, 5259}, {12087, 5262}, {12100, 5265}, {12103, 5267}, {12108, 5269},
+	{12114, 5271}, {12120, 5273}, {12123, 5276}, {12129, 5279}, {12133, 5282}, {12138, 5285}, {12142, 5288}, {12147, 5291},
+	{12151, 5294}, {12156, 5297}, {12161, 5300}, {12168, 5303}, {12175, 5306}, {12180, 5309}, {12193, 5312}, {12199, 5315},
+	{12207, 5318}, {12211, 5321}, {12216, 5324}, {12219, 5327}, {12223, 5330}, {12228, 5336}, {12234, 5338}, {12237, 5339},
+	{12241, 5342}, {12247, 5345}, {12251, 5349}, {12257, 5352}, {12266, 5355}, {12270, 5358}, {12275, 5361}, {12281, 5367},
+	{12286, 5370}, {12292, 5376}, {12297, 5379}, {12303, 5382}, {12311, 5385}, {12321, 5388}, {12326, 5391}, {12332, 5394},
+	{12340, 5397}, {12350, 5400}, {12353, 5403}, {12359, 5406}, {12365, 5409}, {12369, 5412}, {12374, 5415}, {12378, 5419},
+	{12384, 5422}, {12390, 5425}, {12396, 5428}, {12400, 5431}, {12405, 5434}, {12420, 5436}, {12431, 5438}, {12436, 5440},
+	{12439, 5443}, {12443, 5446}, {12449, 5449}, {12453, 5452}, {12460, 5455}, {12467, 5458}, {12472, 5461}, {12477, 5464},
+	{12484, 5467}, {12491, 5470}, {12497, 5473}, {12505, 5476}, {12514, 5479}, {12523, 5482}, {12533, 5485}, {12539, 5488},
+	{12545, 5491}, {12551, 5494}, {12555, 5497}, {12565, 5500}, {12576, 5503}, {12582, 5506}, {12593, 5509}, {12601, 5512},
+	{12609, 5515}, {12616, 5518}, {12619, 5521}, {12623, 5524}, {12626, 5527}, {12630, 5529}, {12634, 5531}, {12638, 5533},
+	{12642, 5536}, {12648, 5539}, {12655, 5542}, {12659, 5545}, {12666, 5548}, {12673, 5551}, {12680, 5554}, {12687, 5557},
+	{12694, 5560}, {12699, 5563}, {12704, 5566}, {12711, 5569}, {12717, 5572}, {12725, 5575}, {12734, 5578}, {12743, 5581},
+	{12753, 5584}, {12759, 5587}, {12765, 5590}, {12771, 5593}, {12776, 5596}, {12782, 5599}, {12787, 5602}, {12794, 5605},
+	{12800, 5608}, {12805, 5610}, {12811, 5613}, {12814, 5615}, {12818, 5618}, {12824, 5620}, {12830, 5622}, {12833, 5624},
+	{12837, 5627}, {12843, 5630}, {12846, 5634}, {12852, 5637}, {12861, 5640}, {12866, 5642}, {12874, 5644}, {12880, 5646},
+	{12891, 5649}, {12899, 5652}, {12905, 5655}, {12910, 5658}, {12916, 5661}, {12921, 5663}, {12926, 5665}, {12931, 5667},
+	{12937, 5670}, {12945, 5673}, {12951, 5676}, {12955, 5679}, {12959, 5682}, {12962, 5685}, {12968, 5688}, {12974, 5691},
+	{12978, 5695}, {12985, 5698}, {12989, 5701}, {12995, 5704}, {13000, 5707}, {13008, 5710}, {13020, 5713}, {13032, 5716},
+	{13046, 5719}, {13055, 5722}, {13068, 5725}, {13083, 5728}, {13089, 5731}, {13093, 5734}, {13101, 5737}, {13108, 5740},
+	{13113, 5743}, {13120, 5746}, {13128, 5749}, {13132, 5753}, {13136, 5755}, {13141, 5757}, {13147, 5759}, {13152, 5762},
+	{13168, 5765}, {13185, 5768}, {13189, 5771}, {13193, 5774}, {13199, 5776}, {13203, 5779}, {13208, 5781}, {13214, 5783},
+	{13219, 5785}, {13222, 5787}, {13227, 5790}, {13233, 5792}, {13238, 5795}, {13244, 5798}, {13247, 5802}, {13253, 5804},
+	{13258, 5807}, {13263, 5810}, {13268, 5813}, {13274, 5816}, {13282, 5819}, {13288, 5822}, {13293, 5825}, {13298, 5827},
+	{13301, 5829}, {13306, 5831}, {13310, 5835}, {13317, 5838}, {13328, 5841}, {13341, 5844}, {13355, 5847}, {13360, 5850},
+	{13364, 5852}, {13369, 5854}, {13376, 5856}, {13386, 5859}, {13392, 5862}, {13400, 5865}, {13406, 5868}, {13411, 5870},
+	{13416, 5873}, {13420, 5877}, {13425, 5880}, {13431, 5882}, {13435, 5885}, {13440, 5888}, {13445, 5891}, {13449, 5893},
+	{13456, 5896}, {13460, 5899}, {13464, 5902}, {13469, 5905}, {13474, 5908}, {13480, 5911}, {13490, 5913}, {13498, 5915},
+	{13508, 5918}, {13514, 5920}, {13519, 5922}, {13528, 5925}, {13532, 5928}, {13538, 5930}, {13546, 5932}, {13558, 5938},
+	{13571, 5944}, {13583, 5950}, {13596, 5956}, {13604, 5958}, {13619, 5961}, {13635, 5964}, {13638, 5966}, {13643, 5969},
+	{13646, 5972}, {13652, 5975}, {13657, 5978}, {13663, 5981}, {13669, 5982}, {13673, 5983}, {13676, 5987}, {13681, 5990},
+	{13686, 5996}, {13691, 6002}, {13695, 6006}, {13700, 6009}, {13705, 6012}, {13709, 6016}, {13715, 6022}, {13721, 6028},
+	{13727, 6034}, {13733, 6040}, {13740, 6043}, {13745, 6045}, {13751, 6048}, {13756, 6051}, {13762, 6054}, {13768, 6057},
+	{13771, 6061}, {13775, 6065}, {13777, 6068}, {13779, 6071}, {13785, 6074}, {13789, 6078}, {13793, 6081}, {13798, 6084},
+	{13802, 6087}, {13807, 6090}, {13810, 6094}, {13815, 6097}, {13820, 6100}, {13822, 6102}, {13827, 6105}, {13832, 6108},
+	{13836, 6111}, {13840, 6114}, {13845, 6117}, {13849, 6121}, {13855, 6124}, {13861, 6127}, {13866, 6130}, {13871, 6133},
+	{13875, 6137}, {13881, 6140}, {13887, 6143}, {13892, 6146}, {13896, 6149}, {13902, 6152}, {13908, 6154}, {13912, 6156},
+	{13917, 6158}, {13920, 6160}, {13923, 6162}, {13926, 6166}, {13930, 6168}, {13934, 6172}, {13938, 6176}, {13942, 6178},
+	{13946, 6180}, {13952, 6182}, {13958, 6184}, {13961, 6186}, {13965, 6188}, {13971, 6191}, {13975, 6193}, {13978, 6197},
+	{13982, 6199}, {13989, 6202}, {13993, 6206}, {13997, 6210}, {14000, 6213}, {14004, 6216},
+}
@@ -0,0 +1,171 @@
+package html2text
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var optionTagRE = regexp.MustCompile(`(?i)<option(?:[\s/][^>]*)?>`)
+
+// WithFormControls instructs the converter to write the state of form controls:
+//
+//	checkboxes as "[x]" or "[ ]", radio buttons as "(x)" or "( )",
+//	text inputs and text areas as "[value]", selects as "[selected option]"
+//	and buttons as "[label]"
+//
+// Hidden inputs are not written.
+func WithFormControls() Option {
+	return func(o *options) {
+		o.formControls = true
+	}
+}
+
+// selectState tracks <option> elements of the open <select>
+type selectState struct {
+	selected []bool // indexed by option
+	index    int    // index of the next option
+	shown    int    // number of options written so far
+}
+
+// formElement writes the state of form controls, rest is the html following the tag
+func (c *converter) formElement(e *element, rest string) {
+	switch e.tagName {
+	case "input":
+		c.writeInput(e)
+
+	case "button", "textarea":
+		if !c.isOpen(e) {
+			return
+		}
+
+		var m *marker
+		if e.tagName == "textarea" {
+			// called after the marker is closed
+			e.onClose = append(e.onClose, func() {
+				if !m.written {
+					c.writeText("[]")
+				}
+			})
+		}
+		m = c.markElement(e, "[", "]")
+
+	case "select":
+		if !c.isOpen(e) {
+			return
+		}
+		c.selectOptions = c.parseOptions(rest, e.hasAttr("multiple"))
+		e.onClose = append(e.onClose, func() {
+			c.selectOptions = nil
+		})
+		c.markElement(e, "[", "]")
+
+	case "option":
+		s := c.selectOptions
+		if s == nil {
+			return
+		}
+		index := s.index
+		s.index++
+		if index >= len(s.selected) || !s.selected[index] {
+			c.hideElement(e)
+			return
+		}
+
+		if s.shown > 0 {
+			c.writeText(", ")
+		}
+		s.shown++
+		if label := e.attr("label"); label != "" {
+			c.writeText(label)
+			c.hideElement(e)
+		}
+	}
+}
+
+// writeInput writes the state of <input> according to its type
+func (c *converter) writeInput(e *element) {
+	value := e.attr("value")
+
+	switch strings.ToLower(strings.TrimSpace(e.attr("type"))) {
+	case "hidden":
+		return
+	case "checkbox":
+		if e.hasAttr("checked") {
+			c.writeText("[x]")
+		} else {
+			c.writeText("[ ]")
+		}
+		c.writeSpace()
+		return
+	case "radio":
+		if e.hasAttr("checked") {
+			c.writeText("(x)")
+		} else {
+			c.writeText("( )")
+		}
+		c.writeSpace()
+		return
+	case "submit":
+		if !e.hasAttr("value") {
+			value = "Submit"
+		}
+	case "reset":
+		if !e.hasAttr("value") {
+			value = "Reset"
+		}
+	case "image":
+		value = e.attr("alt")
+		if value == "" {
+			value = "Submit"
+		}
+	case "password":
+		value = strings.Repeat("*", utf8.RuneCountInString(value))
+	default:
+		if value == "" {
+			value = e.attr("placeholder")
+		}
+	}
+
+	c.writeText("[" + value + "]")
+}
+
+// parseOptions looks ahead for options of the select starting the html
+// to find which are selected, the first one is selected by default
+func (c *converter) parseOptions(html string, multiple bool) *selectState {
+	html = html[:indexSelectEnd(html)]
+
+	s := &selectState{}
+	anySelected := false
+	for _, tag := range optionTagRE.FindAllString(html, -1) {
+		_, selected := parseAttrs(tag[1 : len(tag)-1])["selected"]
+		if selected && !multiple && anySelected {
+			// the last selected option wins
+			for i := range s.selected {
+				s.selected[i] = false
+			}
+		}
+		s.selected = append(s.selected, selected)
+		anySelected = anySelected || selected
+	}
+	if !anySelected && !multiple && len(s.selected) > 0 {
+		s.selected[0] = true
+	}
+	return s
+}
+
+// indexSelectEnd returns the end of the options of the select starting the html,
+// a <select> cannot contain another one so its start tag ends the options as well
+func indexSelectEnd(html string) int {
+	for i := 0; ; i++ {
+		j := strings.IndexByte(html[i:], '<')
+		if j < 0 {
+			return len(html)
+		}
+		i += j
+		tag := html[i+1:]
+		if hasTagName(tag, "select") || strings.HasPrefix(tag, "/") && (hasTagName(tag[1:], "select") || hasTagName(tag[1:], "form")) {
+			return i
+		}
+	}
+}
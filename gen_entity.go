@@ -0,0 +1,116 @@
+//go:build ignore
+// +build ignore
+
+// gen_entity generates entity_table.go from entities.json, the list of named
+// character references published at
+// https://html.spec.whatwg.org/entities.json
+//
+// Only entities terminated by a semicolon are emitted because the converter
+// never decodes references without one. Names are sorted and concatenated
+// into a single string, as are their values, so the table can be searched
+// using binary search without allocating a map at startup.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type entityDef struct {
+	Codepoints []rune `json:"codepoints"`
+	Characters string `json:"characters"`
+}
+
+func main() {
+	data, err := os.ReadFile("entities.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var defs map[string]entityDef
+	if err := json.Unmarshal(data, &defs); err != nil {
+		log.Fatal(err)
+	}
+
+	var names []string
+	for key, def := range defs {
+		if string(def.Codepoints) != def.Characters {
+			log.Fatalf("entity %s: characters do not match codepoints", key)
+		}
+		if strings.HasPrefix(key, "&") && strings.HasSuffix(key, ";") {
+			names = append(names, key[1:len(key)-1])
+		}
+	}
+	sort.Strings(names)
+
+	var (
+		nameBuf, valueBuf strings.Builder
+		offsets           bytes.Buffer
+	)
+	for i := 0; i <= len(names); i++ {
+		if i%8 == 0 {
+			offsets.WriteString("\n\t")
+		} else {
+			offsets.WriteString(" ")
+		}
+		fmt.Fprintf(&offsets, "{%d, %d},", nameBuf.Len(), valueBuf.Len())
+
+		if i < len(names) {
+			nameBuf.WriteString(names[i])
+			valueBuf.WriteString(defs["&"+names[i]+";"].Characters)
+		}
+	}
+	offsets.WriteString("\n")
+
+	if nameBuf.Len() > 0xFFFF || valueBuf.Len() > 0xFFFF {
+		log.Fatal("entity table does not fit uint16 offsets")
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by gen_entity.go from entities.json; DO NOT EDIT.\n\n")
+	out.WriteString("package html2text\n\n")
+	fmt.Fprintf(&out, "// entityNames contains all %d entity names sorted and concatenated\n", len(names))
+	fmt.Fprintf(&out, "const entityNames = %s\n\n", splitQuoted(nameBuf.String()))
+	out.WriteString("// entityValues contains the values of entityNames in the same order\n")
+	fmt.Fprintf(&out, "const entityValues = %s\n\n", splitQuoted(valueBuf.String()))
+	out.WriteString("// entityOffsets holds start offsets of each name and value, followed by the end offsets\n")
+	fmt.Fprintf(&out, "var entityOffsets = [...][2]uint16{%s}\n", offsets.String())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("entity_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// splitQuoted returns s as a concatenation of quoted string literals
+// spread over multiple lines to keep the generated file readable
+func splitQuoted(s string) string {
+	const chunk = 64
+
+	var parts []string
+	for len(s) > 0 {
+		n := chunk
+		if n > len(s) {
+			n = len(s)
+		}
+		// do not split multi-byte characters
+		for n < len(s) && s[n]&0xC0 == 0x80 {
+			n++
+		}
+		parts = append(parts, strconv.QuoteToASCII(s[:n]))
+		s = s[n:]
+	}
+
+	return strings.Join(parts, " +\n\t")
+}
@@ -0,0 +1,157 @@
+package html2text
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// HeadingStyle is a set of decorations applied to headings
+type HeadingStyle int
+
+const (
+	// HeadingPlain only separates headings by empty lines (the default)
+	HeadingPlain HeadingStyle = 0
+	// HeadingUnderline underlines headings by "=" (h1) or "-" (other levels) to their display width
+	HeadingUnderline HeadingStyle = 1 << (iota - 1)
+	// HeadingATX prefixes headings by "#" repeated according to their level, as in Markdown
+	HeadingATX
+	// HeadingUppercase converts headings to upper case
+	HeadingUppercase
+)
+
+// WithHeadingStyle instructs the converter to decorate headings of the specified levels (1-6),
+// or of all levels if none is specified. Styles can be combined, e.g. HeadingUnderline|HeadingUppercase.
+// Headings use HeadingATX by default in Markdown mode.
+func WithHeadingStyle(style HeadingStyle, levels ...int) Option {
+	return func(o *options) {
+		if len(levels) == 0 {
+			levels = []int{1, 2, 3, 4, 5, 6}
+		}
+		for _, level := range levels {
+			if level >= 1 && level <= 6 {
+				o.headingStyles[level-1] = style
+			}
+		}
+	}
+}
+
+// WithHeadingNumbers instructs the converter to number headings by their section, e.g. "1.2.3 Title"
+func WithHeadingNumbers() Option {
+	return func(o *options) {
+		o.headingNumbers = true
+	}
+}
+
+func (c *converter) headingStyle(level int) HeadingStyle {
+	if style := c.opts.headingStyles[level-1]; style != HeadingPlain {
+		return style
+	}
+	if c.opts.markdown {
+		return HeadingATX
+	}
+	return HeadingPlain
+}
+
+// openHeading starts a heading of the level, its prefix is written with the first text
+func (c *converter) openHeading(level int) {
+	c.closeHeading()
+	c.blockBreak(2)
+	c.headingLevel = level
+	c.headingStart = -1
+
+	prefix := ""
+	if c.opts.headingNumbers {
+		c.sectionNumbers[level-1]++
+		for i := level; i < len(c.sectionNumbers); i++ {
+			c.sectionNumbers[i] = 0
+		}
+
+		// skip levels not used by the document, e.g. when it starts with <h2>
+		first := 0
+		for first < level-1 && c.sectionNumbers[first] == 0 {
+			first++
+		}
+		numbers := make([]string, 0, level-first)
+		for _, n := range c.sectionNumbers[first:level] {
+			numbers = append(numbers, strconv.Itoa(n))
+		}
+		prefix = strings.Join(numbers, ".") + " "
+	}
+	if c.headingStyle(level)&HeadingATX != 0 {
+		prefix = strings.Repeat("#", level) + " " + prefix
+	}
+	c.pendingPrefix = prefix
+}
+
+// closeHeading applies the heading style to the heading text written since openHeading
+func (c *converter) closeHeading() {
+	level := c.headingLevel
+	if level == 0 {
+		return
+	}
+	c.headingLevel = 0
+
+	if c.headingStart < 0 {
+		// no text, drop the prefix
+		c.pendingPrefix = ""
+		c.blockBreak(2)
+		return
+	}
+
+	style := c.headingStyle(level)
+	if style&HeadingUppercase != 0 {
+		heading := strings.ToUpper(string(c.outBuf.Bytes()[c.headingStart:]))
+		c.outBuf.Truncate(c.headingStart)
+		c.outBuf.WriteString(heading)
+	}
+	if style&HeadingUnderline != 0 {
+		underline := "-"
+		if level == 1 {
+			underline = "="
+		}
+		// only the last line of a heading broken by <br> is underlined
+		line := string(c.outBuf.Bytes()[c.headingStart:])
+		if i := strings.LastIndexByte(line, '\n'); i >= 0 {
+			line = strings.TrimPrefix(line[i+1:], strings.Join(c.indents, ""))
+		}
+		width := displayWidth(line)
+		c.blockBreak(1)
+		c.writeText(strings.Repeat(underline, width))
+	}
+	c.blockBreak(2)
+}
+
+// displayWidth returns the number of terminal columns needed to display s,
+// counting East Asian wide characters twice and combining marks not at all
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case isWideRune(r):
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+// isWideRune reports whether r is an East Asian wide or fullwidth character
+func isWideRune(r rune) bool {
+	return r >= 0x1100 && r <= 0x115F || // Hangul Jamo
+		r >= 0x2E80 && r <= 0x303E || // CJK Radicals .. CJK Symbols and Punctuation
+		r >= 0x3041 && r <= 0x33FF || // Hiragana .. CJK Compatibility
+		r >= 0x3400 && r <= 0x4DBF || // CJK Unified Ideographs Extension A
+		r >= 0x4E00 && r <= 0x9FFF || // CJK Unified Ideographs
+		r >= 0xA000 && r <= 0xA4CF || // Yi
+		r >= 0xAC00 && r <= 0xD7A3 || // Hangul Syllables
+		r >= 0xF900 && r <= 0xFAFF || // CJK Compatibility Ideographs
+		r >= 0xFE30 && r <= 0xFE4F || // CJK Compatibility Forms
+		r >= 0xFF00 && r <= 0xFF60 || // Fullwidth Forms
+		r >= 0xFFE0 && r <= 0xFFE6 ||
+		r >= 0x1F300 && r <= 0x1F64F || // Emoji
+		r >= 0x1F900 && r <= 0x1F9FF ||
+		r >= 0x20000 && r <= 0x3FFFD // CJK Unified Ideographs Extension B ..
+}
@@ -3,8 +3,9 @@ package html2text
 import (
 	"bytes"
 	"regexp"
-	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Line break constants
@@ -18,13 +19,39 @@ var legacyLBR = WIN_LBR
 var badTagnamesRE = regexp.MustCompile(`^(head|script|style|a)($|\s+)`)
 var linkTagRE = regexp.MustCompile(`^(?i:a)(?:$|\s).*(?i:href)\s*=\s*('([^']*?)'|"([^"]*?)"|([^\s"'` + "`" + `=<>]+))`)
 var badLinkHrefRE = regexp.MustCompile(`javascript:`)
-var headersRE = regexp.MustCompile(`^(\/)?h[1-6]`)
+var headersRE = regexp.MustCompile(`^h[1-6]$`)
 var numericEntityRE = regexp.MustCompile(`(?i)^#(x?[a-f0-9]+)$`)
 
+// longestEntityName is the length of the longest name in entities.json, CounterClockwiseContourIntegral
+const longestEntityName = 31
+
 type options struct {
-	lbr            string
-	linksInnerText bool
-	listPrefix     string
+	lbr               string
+	linksInnerText    bool
+	listPrefix        string
+	charset           string
+	charsetDecoder    CharsetDecoder
+	hrRule            string
+	markdown          bool
+	headingStyles     [6]HeadingStyle
+	headingNumbers    bool
+	emphasis          map[string]string
+	superSubscripts   bool
+	asciiQuotes       bool
+	abbrMode          AbbrMode
+	titleAttributes   bool
+	rubyMode          RubyMode
+	bidiIsolates      bool
+	bidiSanitizing    bool
+	whitespacePolicy  *WhitespacePolicy
+	normalizeLines    bool
+	maxBlankLines     int
+	css               bool
+	formControls      bool
+	openDetailsOnly   bool
+	mediaPlaceholders bool
+	mathAnnotations   bool
+	aria              bool
 }
 
 func newOptions() *options {
@@ -64,32 +91,129 @@ func WithListSupport() Option {
 	return WithListSupportPrefix(" - ")
 }
 
+// WithHorizontalRule instructs the converter to render <hr> as the specified separator
+// on its own line, e.g. strings.Repeat("-", 72) or "* * *"
+func WithHorizontalRule(rule string) Option {
+	return func(o *options) {
+		o.hrRule = rule
+	}
+}
+
+// WithMarkdown instructs the converter to use Markdown syntax for the elements
+// which support it, e.g. <hr> becomes "---" unless WithHorizontalRule is used
+func WithMarkdown() Option {
+	return func(o *options) {
+		o.markdown = true
+	}
+}
+
+// windows1252 maps numeric character references in the C1 control range
+// (0x80-0x9F) to the characters they represent in Windows-1252, as required by
+// https://html.spec.whatwg.org/multipage/parsing.html#numeric-character-reference-end-state
+// Zero entries have no mapping and are kept as they are.
+var windows1252 = [32]rune{
+	0x20AC, 0, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0, 0x017D, 0,
+	0, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0, 0x017E, 0x0178,
+}
+
+// parseNumericEntity converts the digits of a numeric character reference
+// into a rune following the WHATWG rules. NUL, surrogates and values beyond
+// unicode.MaxRune become U+FFFD, C1 controls are mapped through windows1252.
+func parseNumericEntity(digits string, base int) (rune, bool) {
+	var n int64
+	for _, d := range digits {
+		var v int64
+		switch {
+		case d >= '0' && d <= '9':
+			v = int64(d - '0')
+		case d >= 'a' && d <= 'f':
+			v = int64(d-'a') + 10
+		case d >= 'A' && d <= 'F':
+			v = int64(d-'A') + 10
+		}
+		if v >= int64(base) {
+			return 0, false
+		}
+
+		// saturate instead of overflowing, anything this large is out of range anyway
+		if n <= unicode.MaxRune {
+			n = n*int64(base) + v
+		}
+	}
+
+	switch {
+	case n == 0, n > unicode.MaxRune, n >= 0xD800 && n <= 0xDFFF:
+		return unicode.ReplacementChar, true
+	case n >= 0x80 && n <= 0x9F:
+		if r := windows1252[n-0x80]; r != 0 {
+			return r, true
+		}
+		return rune(n), true
+	case n < 32 && n != 9 && n != 10 && n != 13:
+		// other C0 controls are not printable
+		return 0, false
+	}
+
+	return rune(n), true
+}
+
 func parseHTMLEntity(entName string) (string, bool) {
-	if r, ok := entity[entName]; ok {
-		return string(r), true
+	if ent, ok := lookupEntity(entName); ok {
+		return ent, true
 	}
 
 	if match := numericEntityRE.FindStringSubmatch(entName); len(match) == 2 {
 		var (
-			err    error
-			n      int64
+			r      rune
+			ok     bool
 			digits = match[1]
 		)
 
 		if digits != "" && (digits[0] == 'x' || digits[0] == 'X') {
-			n, err = strconv.ParseInt(digits[1:], 16, 64)
+			r, ok = parseNumericEntity(digits[1:], 16)
 		} else {
-			n, err = strconv.ParseInt(digits, 10, 64)
+			r, ok = parseNumericEntity(digits, 10)
+		}
+
+		if ok {
+			return string(r), true
+		}
+	}
+
+	return "", false
+}
+
+// scanHTMLEntity returns the name of the entity reference at the beginning
+// of s (the text following '&') and whether it is terminated by ';'.
+// Named entities are limited to the longest name in the entity table,
+// numeric ones may be of any length.
+func scanHTMLEntity(s string) (string, bool) {
+	numeric := strings.HasPrefix(s, "#")
+
+	chars := 0
+	for j, er := range s {
+		if er == ';' {
+			return s[:j], true
 		}
 
-		if err == nil && (n == 9 || n == 10 || n == 13 || n > 31) {
-			return string(rune(n)), true
+		chars++
+		if numeric && chars > 1 && !isASCIIAlnum(er) {
+			break
+		}
+		if !numeric && chars > longestEntityName {
+			break
 		}
 	}
 
 	return "", false
 }
 
+func isASCIIAlnum(r rune) bool {
+	return r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
 // SetUnixLbr with argument true sets Unix-style line-breaks in output ("\n")
 // with argument false sets Windows-style line-breaks in output ("\r\n", the default)
 // Deprecated: Please use HTML2TextWithOptions(text, WithUnixLineBreak())
@@ -114,26 +238,7 @@ func HTMLEntitiesToText(htmlEntsText string) string {
 			continue
 
 		case r == '&': //possible html entity
-			entName := ""
-			isEnt := false
-
-			// parse the entity name - max 10 chars
-			chars := 0
-			for _, er := range htmlEntsText[i+1:] {
-				if er == ';' {
-					isEnt = true
-					break
-				} else {
-					entName += string(er)
-				}
-
-				chars++
-				if chars == 10 {
-					break
-				}
-			}
-
-			if isEnt {
+			if entName, isEnt := scanHTMLEntity(htmlEntsText[i+1:]); isEnt {
 				if ent, isEnt := parseHTMLEntity(entName); isEnt {
 					outBuf.WriteString(ent)
 					inEnt = true
@@ -150,11 +255,371 @@ func HTMLEntitiesToText(htmlEntsText string) string {
 	return outBuf.String()
 }
 
-func writeSpace(outBuf *bytes.Buffer) {
-	bts := outBuf.Bytes()
-	if len(bts) > 0 && bts[len(bts)-1] != ' ' {
-		outBuf.WriteString(" ")
+// converter holds the output state of a single conversion
+type converter struct {
+	opts   *options
+	outBuf *bytes.Buffer
+
+	badTagStackDepth int // if == 1 it means we are inside <head>...</head>
+	// maintain a stack of <a> tag href links and output it after the tag's inner text (for opts.linksInnerText only)
+	hrefs []string
+
+	// a collapsible space is written only if followed by text on the same line
+	pendingSpace bool
+	// number of line breaks requested by block elements before the next text,
+	// they are never printed at the beginning or the end of the document
+	pendingLbr int
+	// number of line breaks the output currently ends with
+	trailingLbr int
+	// written before the next text, e.g. decoration of the current heading
+	pendingPrefix string
+	// written at the beginning of each line, e.g. for <dd>
+	indents []string
+	// inline markers of open elements, see pushMarker
+	markers []*marker
+	// open elements, see openElement
+	stack []*element
+	// number of open <q> elements
+	quoteDepth int
+	// abbreviations expanded so far
+	abbrSeen map[string]bool
+	// written at the end of the document by writeFootnotes
+	footnotes []string
+
+	// level of the current heading, 0 outside of headings
+	headingLevel int
+	// output offset of the current heading or -1 if no text has been written yet
+	headingStart int
+	// counters for WithHeadingNumbers
+	sectionNumbers [6]int
+
+	// rules from <style> elements for WithCSS
+	cssRules []*cssRule
+	// white-space property values of open elements for WithCSS
+	whiteSpace []string
+	// a line break right after <pre> is ignored
+	skipNewline bool
+	// options of the open <select> for WithFormControls
+	selectOptions *selectState
+	// open <details> elements
+	details []*detailsState
+	// elements with an id for aria-labelledby, indexed by textByID on the first reference
+	ids map[string]*idElement
+	// collected by HTML2TextWithMetadata, nil otherwise
+	meta *Metadata
+	// the first <title> outside of <svg> has been read for meta
+	titleSeen bool
+	// links are collected by HTML2TextWithLinks
+	collectLinks bool
+	links        []*Link
+	// the last link which has no output yet
+	pendingLink *Link
+	// open links collecting their text
+	openLinks []*openLink
+}
+
+func newConverter(opts *options) *converter {
+	return &converter{
+		opts:   opts,
+		outBuf: bytes.NewBufferString(""),
+		hrefs:  []string{},
+	}
+}
+
+func (c *converter) hidden() bool {
+	return c.badTagStackDepth > 0
+}
+
+// flushLbr writes line breaks requested by block elements
+func (c *converter) flushLbr() {
+	if c.outBuf.Len() > 0 {
+		for c.trailingLbr < c.pendingLbr {
+			c.outBuf.WriteString(c.opts.lbr)
+			c.trailingLbr++
+		}
+	}
+	c.pendingLbr = 0
+	c.pendingSpace = false
+}
+
+// flushSpace writes the pending space unless the line already ends with one
+func (c *converter) flushSpace() {
+	if c.pendingSpace {
+		bts := c.outBuf.Bytes()
+		if len(bts) > 0 && bts[len(bts)-1] != ' ' && c.trailingLbr == 0 {
+			c.outBuf.WriteString(" ")
+		}
+		c.pendingSpace = false
+	}
+}
+
+// writeText writes visible text, preceded by pending line breaks or space
+func (c *converter) writeText(text string) {
+	if c.opts.bidiSanitizing {
+		text = bidiControlsReplacer.Replace(text)
+	}
+	c.writeLinkText(text)
+	if c.hidden() || text == "" {
+		return
+	}
+	c.skipNewline = false
+
+	if c.pendingLbr > 0 {
+		c.flushLbr()
+	} else {
+		c.flushSpace()
+	}
+
+	if c.trailingLbr > 0 || c.outBuf.Len() == 0 {
+		for _, indent := range c.indents {
+			c.outBuf.WriteString(indent)
+		}
+	}
+	if c.headingLevel > 0 && c.headingStart < 0 {
+		c.headingStart = c.outBuf.Len()
+	}
+	c.outBuf.WriteString(c.pendingPrefix)
+	c.pendingPrefix = ""
+	for _, m := range c.markers {
+		if !m.written {
+			c.outBuf.WriteString(m.open)
+			m.written = true
+			m.start = c.outBuf.Len()
+		}
+	}
+
+	if c.pendingLink != nil {
+		c.pendingLink.Offset = c.outBuf.Len()
+		c.pendingLink = nil
+	}
+	c.outBuf.WriteString(text)
+	c.trailingLbr = 0
+}
+
+// writeSpace requests a single collapsible space
+func (c *converter) writeSpace() {
+	c.writeLinkText(" ")
+	if !c.hidden() {
+		c.pendingSpace = true
+	}
+}
+
+// writeLbr writes a line break unconditionally, as for <br>
+func (c *converter) writeLbr() {
+	c.writeLinkText(" ")
+	if c.hidden() {
+		return
+	}
+
+	c.flushLbr()
+	c.outBuf.WriteString(c.opts.lbr)
+	c.trailingLbr++
+}
+
+// blockBreak requests the next text to start on a new line, n == 2 means
+// it should also be separated by an empty line. Requests do not add up.
+func (c *converter) blockBreak(n int) {
+	c.writeLinkText(" ")
+	if c.hidden() {
+		return
+	}
+
+	if n > c.pendingLbr {
+		c.pendingLbr = n
+	}
+	c.pendingSpace = false
+}
+
+// endLine makes sure the output ends with a line break right away,
+// even at the end of the document (the original behavior of </ul>)
+func (c *converter) endLine() {
+	c.writeLinkText(" ")
+	if c.hidden() {
+		return
+	}
+
+	c.flushLbr()
+	if c.trailingLbr == 0 && c.outBuf.Len() > 0 {
+		c.outBuf.WriteString(c.opts.lbr)
+		c.trailingLbr++
+	}
+}
+
+// marker is a pair of strings written around the text of an element
+type marker struct {
+	tagName     string
+	open, close string
+	// the open string is written together with the first text of the element
+	// so there are no markers around elements without text
+	written bool
+	// output offset of the text after the open string
+	start int
+	// optional transformation of the text when the element is closed
+	transform func(text string) string
+}
+
+// pushMarker starts an element with text marked by the open and close strings
+func (c *converter) pushMarker(tagName, open, close string) *marker {
+	m := &marker{tagName: tagName, open: open, close: close}
+	c.markers = append(c.markers, m)
+	return m
+}
+
+// popMarker ends the last element started by pushMarker with the tag name,
+// elements started after it and not closed yet are ended as well
+func (c *converter) popMarker(tagName string) {
+	for i := len(c.markers) - 1; i >= 0; i-- {
+		if c.markers[i].tagName == tagName {
+			c.closeMarker(c.markers[i])
+			return
+		}
+	}
+}
+
+// closeMarker ends the element started by pushMarker and those started after it
+func (c *converter) closeMarker(m *marker) {
+	for i := len(c.markers) - 1; i >= 0; i-- {
+		if c.markers[i] != m {
+			continue
+		}
+
+		for len(c.markers) > i {
+			m := c.markers[len(c.markers)-1]
+			c.markers = c.markers[:len(c.markers)-1]
+			if !m.written || c.hidden() {
+				continue
+			}
+
+			if m.transform != nil && m.start <= c.outBuf.Len() {
+				written := string(c.outBuf.Bytes()[m.start:])
+				text := m.transform(written)
+				c.moveLinkOffsets(m.start, written, text)
+				c.outBuf.Truncate(m.start)
+				c.outBuf.WriteString(text)
+			}
+			// the pending space, if any, belongs after the marker
+			c.outBuf.WriteString(m.close)
+			c.trailingLbr = 0
+		}
+		return
+	}
+}
+
+// pushIndent indents all following lines until popIndent
+func (c *converter) pushIndent(indent string) {
+	c.indents = append(c.indents, indent)
+}
+
+func (c *converter) popIndent() {
+	if len(c.indents) > 0 {
+		c.indents = c.indents[:len(c.indents)-1]
+	}
+}
+
+// horizontalRule writes the separator line for <hr>
+func (c *converter) horizontalRule() {
+	switch {
+	case c.opts.hrRule != "":
+		c.blockBreak(1)
+		c.writeText(c.opts.hrRule)
+		c.blockBreak(1)
+	case c.opts.markdown:
+		// blank lines prevent turning the previous line into a heading
+		c.blockBreak(2)
+		c.writeText("---")
+		c.blockBreak(2)
+	default:
+		c.blockBreak(1)
+	}
+}
+
+// String returns the output, writing the pending space if any
+func (c *converter) String() string {
+	if c.pendingLbr == 0 {
+		c.flushSpace()
+	}
+	return c.outBuf.String()
+}
+
+// blockTags are elements starting on a new line
+// (p, h1-h6, hr and lists are handled separately)
+var blockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "caption": true,
+	"center": true, "details": true, "dialog": true, "dir": true, "div": true,
+	"fieldset": true, "figcaption": true, "footer": true, "form": true,
+	"header": true, "hgroup": true, "legend": true, "main": true, "menu": true,
+	"nav": true, "noscript": true, "pre": true, "section": true, "summary": true,
+	"table": true, "tbody": true, "tfoot": true, "thead": true, "tr": true,
+}
+
+// parseTagName returns the lowercase name of the tag (the text between < and >)
+// and whether it is a closing tag
+func parseTagName(tagNameLowercase string) (string, bool) {
+	closing := strings.HasPrefix(tagNameLowercase, "/")
+	name := strings.TrimPrefix(tagNameLowercase, "/")
+	if end := strings.IndexAny(name, " \t\r\n\f/"); end >= 0 {
+		name = name[:end]
+	}
+	return name, closing
+}
+
+// indexFold returns the index of the first occurrence of ASCII substr in s, ignoring case
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// indexEndTag returns the index of the first end tag of the element with the lowercase
+// tag name in s, unlike indexFold(s, "</b") it does not match "</body>"
+func indexEndTag(s, tagName string) int {
+	for i := 0; ; i++ {
+		j := indexFold(s[i:], "</"+tagName)
+		if j < 0 {
+			return -1
+		}
+		i += j
+		if hasTagName(s[i+2:], tagName) {
+			return i
+		}
+	}
+}
+
+// indexMatchingEndTag returns the index of the end tag closing the element with
+// the lowercase tag name whose content starts s, skipping nested elements
+// with the same name, or -1 if the element is not closed
+func indexMatchingEndTag(s, tagName string) int {
+	depth := 0
+	for i := 0; ; i++ {
+		j := strings.IndexByte(s[i:], '<')
+		if j < 0 {
+			return -1
+		}
+		i += j
+		switch {
+		case strings.HasPrefix(s[i+1:], "/") && hasTagName(s[i+2:], tagName):
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case hasTagName(s[i+1:], tagName):
+			if end := strings.IndexByte(s[i:], '>'); end < 0 || s[i+end-1] != '/' {
+				depth++
+			}
+		}
+	}
+}
+
+// hasTagName reports whether s starts with the lowercase tag name, ignoring case,
+// which is not followed by other characters of a name
+func hasTagName(s, tagName string) bool {
+	if len(s) < len(tagName) || !strings.EqualFold(s[:len(tagName)], tagName) {
+		return false
 	}
+	return len(s) == len(tagName) || strings.IndexByte(">/ \t\r\n\f", s[len(tagName)]) >= 0
 }
 
 // HTML2Text converts html into a text form
@@ -173,32 +638,37 @@ func HTML2TextWithOptions(html string, reqOpts ...Option) string {
 		opt(opts)
 	}
 
-	inLen := len(html)
+	return newConverter(opts).convert(html)
+}
+
+// convert converts html into a text form using the options of the converter
+func (c *converter) convert(html string) string {
+	opts := c.opts
 	tagStart := 0
+	skipUntil := 0 // end of raw text of <script> and <style>
 	inEnt := false
-	badTagStackDepth := 0 // if == 1 it means we are inside <head>...</head>
 	shouldOutput := true
-	// maintain a stack of <a> tag href links and output it after the tag's inner text (for opts.linksInnerText only)
-	hrefs := []string{}
-	// new line cannot be printed at the beginning or
-	// for <p> after a new line created by previous <p></p>
-	canPrintNewline := false
-
-	outBuf := bytes.NewBufferString("")
 
 	for i, r := range html {
-		if inLen > 0 && i == inLen-1 {
-			// prevent new line at the end of the document
-			canPrintNewline = false
+		if i < skipUntil {
+			continue
+		}
+
+		if opts.whitespacePolicy != nil && shouldOutput && !inEnt && isSpecialWhitespace(r) {
+			c.writeSpecialWhitespace(r, html[i+utf8.RuneLen(r):])
+			continue
 		}
 
 		switch {
 		// skip new lines and spaces adding a single space if not there yet
 		case r <= 0xD, r == 0x85, r == 0x2028, r == 0x2029, // new lines
 			r == ' ', r >= 0x2008 && r <= 0x200B: // spaces
-			if shouldOutput && badTagStackDepth == 0 && !inEnt {
-				//outBuf.WriteString(fmt.Sprintf("{DBG r:%c, inEnt:%t, tag:%s}", r, inEnt, html[tagStart:i]))
-				writeSpace(outBuf)
+			if shouldOutput && !inEnt {
+				if opts.css {
+					c.writeWhitespace(r)
+				} else {
+					c.writeSpace()
+				}
 			}
 			continue
 
@@ -207,28 +677,9 @@ func HTML2TextWithOptions(html string, reqOpts ...Option) string {
 			continue
 
 		case r == '&' && shouldOutput: // possible html entity
-			entName := ""
-			isEnt := false
-
-			// parse the entity name - max 10 chars
-			chars := 0
-			for _, er := range html[i+1:] {
-				if er == ';' {
-					isEnt = true
-					break
-				} else {
-					entName += string(er)
-				}
-
-				chars++
-				if chars == 10 {
-					break
-				}
-			}
-
-			if isEnt {
+			if entName, isEnt := scanHTMLEntity(html[i+1:]); isEnt {
 				if ent, isEnt := parseHTMLEntity(entName); isEnt {
-					outBuf.WriteString(ent)
+					c.writeEntity(ent, html[i+len(entName)+2:])
 					inEnt = true
 					continue
 				}
@@ -243,36 +694,110 @@ func HTML2TextWithOptions(html string, reqOpts ...Option) string {
 			shouldOutput = true
 			tag := html[tagStart:i]
 			tagNameLowercase := strings.ToLower(tag)
+			tagName, closing := parseTagName(tagNameLowercase)
+
+			var elem *element
+			if closing {
+				elem = c.closeElement(tagName)
+			} else if tagName != "" {
+				elem = c.openElement(tagName, tag)
+				if opts.css {
+					c.cssElement(elem)
+				}
+				if opts.superSubscripts {
+					c.scriptElement(elem)
+				}
+				if tagName == "q" {
+					c.quoteElement(elem)
+				}
+				c.titleElement(elem)
+				c.rubyElement(elem)
+				c.bidiElement(elem)
+				if opts.formControls {
+					c.formElement(elem, html[i+1:])
+				}
+				if tagName == "details" || tagName == "summary" || tagName == "dialog" {
+					c.detailsElement(elem, html[i+1:])
+				}
+				if opts.mediaPlaceholders {
+					c.mediaElement(elem, html[i+1:])
+				}
+				if opts.aria {
+					c.ariaElement(elem, html)
+				}
+				if c.meta != nil {
+					c.metadataElement(elem, html[i+1:])
+				}
+				if c.collectLinks && tagName == "a" {
+					c.linkElement(elem)
+				}
+				if tagName == "svg" {
+					c.svgElement(elem, html[i+1:])
+				} else if tagName == "math" {
+					c.mathElement(elem, html[i+1:])
+				}
+			}
+
+			if (tagName == "script" || tagName == "style") && !closing && !strings.HasSuffix(tag, "/") {
+				// raw text, skip it up to the end tag even if it contains "<" or ">"
+				skipUntil = len(html)
+				if end := indexEndTag(html[i+1:], tagName); end >= 0 {
+					skipUntil = i + 1 + end
+				}
+				if tagName == "style" && opts.css {
+					c.addStyleSheet(html[i+1 : skipUntil])
+				}
+			}
 
-			if tagNameLowercase == "/ul" || tagNameLowercase == "/ol" {
-				outBuf.WriteString(opts.lbr)
-			} else if tagNameLowercase == "li" || tagNameLowercase == "li/" {
-				if opts.listPrefix != "" {
-					outBuf.WriteString(opts.lbr + opts.listPrefix)
+			if tagName == "ul" || tagName == "ol" {
+				if closing {
+					c.endLine()
 				} else {
-					outBuf.WriteString(opts.lbr)
+					c.blockBreak(1)
 				}
-			} else if headersRE.MatchString(tagNameLowercase) {
-				if canPrintNewline {
-					outBuf.WriteString(opts.lbr + opts.lbr)
+			} else if tagName == "li" {
+				c.blockBreak(1)
+				if !closing && opts.listPrefix != "" {
+					c.writeText(opts.listPrefix)
 				}
-				canPrintNewline = false
-			} else if tagNameLowercase == "br" || tagNameLowercase == "br/" {
+			} else if headersRE.MatchString(tagName) {
+				if closing {
+					c.closeHeading()
+				} else {
+					c.openHeading(int(tagName[1] - '0'))
+				}
+			} else if tagName == "br" {
 				// new line
-				outBuf.WriteString(opts.lbr)
-			} else if tagNameLowercase == "p" || tagNameLowercase == "/p" {
-				if canPrintNewline {
-					outBuf.WriteString(opts.lbr + opts.lbr)
+				c.writeLbr()
+			} else if tagName == "p" || tagName == "figure" {
+				if !elem.isInline() {
+					c.blockBreak(2)
+				}
+			} else if tagName == "dl" || tagName == "dt" || tagName == "dd" {
+				if !closing {
+					c.definitionList(elem)
 				}
-				canPrintNewline = false
+			} else if marker, ok := c.emphasisMarker(tagName); ok && !closing {
+				c.markElement(elem, marker, marker)
+			} else if tagName == "wbr" {
+				if opts.whitespacePolicy != nil && opts.whitespacePolicy.SoftHyphenBreaks {
+					c.writeText("\u200B")
+				}
+			} else if tagName == "hr" {
+				c.horizontalRule()
+			} else if blockTags[tagName] {
+				if !elem.isInline() {
+					c.blockBreak(1)
+				}
+			} else if tagName == "td" || tagName == "th" {
+				// table cells are separated at least by a space
+				c.writeSpace()
 			} else if opts.linksInnerText && tagNameLowercase == "/a" {
 				// end of link
 				// links can be empty can happen if the link matches the badLinkHrefRE
-				if len(hrefs) > 0 {
-					outBuf.WriteString(" <")
-					outBuf.WriteString(HTMLEntitiesToText(hrefs[0]))
-					outBuf.WriteString(">")
-					hrefs = hrefs[1:]
+				if len(c.hrefs) > 0 {
+					c.writeText(" <" + HTMLEntitiesToText(c.hrefs[0]) + ">")
+					c.hrefs = c.hrefs[1:]
 				}
 			} else if opts.linksInnerText && linkTagRE.MatchString(tagNameLowercase) {
 				// parse link href
@@ -288,13 +813,10 @@ func HTML2TextWithOptions(html string, reqOpts ...Option) string {
 					}
 
 					if opts.linksInnerText && !badLinkHrefRE.MatchString(link) {
-						hrefs = append(hrefs, link)
+						c.hrefs = append(c.hrefs, link)
 					}
 				}
 			} else if badTagnamesRE.MatchString(tagNameLowercase) {
-				// unwanted block
-				badTagStackDepth++
-
 				// if link inner text preservation is not enabled
 				// and the current tag is a link tag, parse its href and output that
 				if !opts.linksInnerText {
@@ -310,24 +832,44 @@ func HTML2TextWithOptions(html string, reqOpts ...Option) string {
 						}
 
 						if !badLinkHrefRE.MatchString(link) {
-							outBuf.WriteString(HTMLEntitiesToText(link))
+							c.writeText(HTMLEntitiesToText(link))
 						}
 					}
 				}
+
+				// unwanted block
+				c.badTagStackDepth++
 			} else if len(tagNameLowercase) > 0 && tagNameLowercase[0] == '/' &&
 				badTagnamesRE.MatchString(tagNameLowercase[1:]) {
 				// end of unwanted block
-				badTagStackDepth--
+				c.badTagStackDepth--
+			}
+
+			if opts.aria && elem != nil && !closing {
+				c.ariaLabel(elem, html)
 			}
 			continue
 
 		} // switch end
 
-		if shouldOutput && badTagStackDepth == 0 && !inEnt {
-			canPrintNewline = true
-			outBuf.WriteRune(r)
+		if shouldOutput && !inEnt {
+			c.writeText(string(r))
 		}
 	}
 
-	return outBuf.String()
+	c.closeElements(0)
+	c.closeHeading()
+	if len(c.markers) > 0 {
+		c.popMarker(c.markers[0].tagName)
+	}
+	c.writeFootnotes()
+
+	if opts.normalizeLines {
+		offsets := make([]*int, len(c.links))
+		for i, l := range c.links {
+			offsets[i] = &l.Offset
+		}
+		return normalizeLines(c.String(), opts, offsets...)
+	}
+	return c.String()
 }
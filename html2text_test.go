@@ -1,6 +1,9 @@
 package html2text
 
 import (
+	"encoding/json"
+	"os"
+	"strings"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -49,7 +52,84 @@ func TestHTML2Text(t *testing.T) {
 
 		Convey("Inlines", func() {
 			So(HTML2Text(`strong <strong>text</strong>`), ShouldEqual, "strong text")
-			So(HTML2Text(`some <div id="a" class="b">div</div>`), ShouldEqual, "some div")
+			So(HTML2Text(`some <span id="a" class="b">span</span>`), ShouldEqual, "some span")
+		})
+
+		Convey("Emphasis markers", func() {
+			So(HTML2Text(`<b>bold</b> <i>italic</i>`), ShouldEqual, "bold italic")
+			So(HTML2TextWithOptions(`<b>bold</b> <i>italic</i> <u>under</u> <del>gone</del> <s>old</s> <mark>hi</mark> <code>x := 1</code>`, WithEmphasis()),
+				ShouldEqual, "*bold* /italic/ _under_ ~~gone~~ ~~old~~ ==hi== `x := 1`")
+			So(HTML2TextWithOptions(`a<strong> spaced </strong>b <em> </em>c<b></b>`, WithEmphasis()), ShouldEqual, "a *spaced* b c")
+			So(HTML2TextWithOptions(`<b>bold <i>both</i></b> <i><b>x</i> y</b>`, WithEmphasis()), ShouldEqual, "*bold /both/* /*x*/ y")
+			So(HTML2TextWithOptions(`<strong>bold</strong> <em>italic</em> <u>plain</u>`, WithMarkdown()), ShouldEqual, "**bold** *italic* plain")
+			So(HTML2TextWithOptions(`<b>bold</b> <tt>tt</tt>`, WithEmphasisMarkers(map[string]string{"tt": "'"})), ShouldEqual, "bold 'tt'")
+			So(HTML2TextWithOptions(`<b>unclosed`, WithEmphasis()), ShouldEqual, "*unclosed*")
+			So(HTML2TextWithOptions(`a <b/>b <i/>c`, WithEmphasis()), ShouldEqual, "a b c")
+			So(HTML2TextWithOptions(`a <b/>b <i/>c <i>d</i>`, WithMarkdown()), ShouldEqual, "a b c *d*")
+		})
+
+		Convey("Superscripts and subscripts", func() {
+			So(HTML2Text(`x<sup>2</sup> H<sub>2</sub>O`), ShouldEqual, "x2 H2O")
+			So(HTML2TextWithOptions(`x<sup>2</sup> H<sub>2</sub>O e<sup>-(n+1)</sup> x<sub>i</sub>`, WithSuperSubscripts()), ShouldEqual, "x² H₂O e⁻⁽ⁿ⁺¹⁾ xᵢ")
+			So(HTML2TextWithOptions(`see<sup><a href="#fn1">[1]</a></sup> CO<sub>2 (g)</sub>`, WithSuperSubscripts(), WithLinksInnerText()),
+				ShouldEqual, "see^([1] <#fn1>) CO_(2 (g))")
+			So(HTML2TextWithOptions(`x<sup> </sup>y <sup><sub>2</sub></sup>`, WithSuperSubscripts()), ShouldEqual, "x y ^(₂)")
+			So(HTML2TextWithOptions(`<small>fine print</small> <span style="font-variant: small-caps">Small Caps <span>too</span></span> end`, WithSuperSubscripts()),
+				ShouldEqual, "ꜰɪɴᴇ ᴘʀɪɴᴛ SMALL CAPS TOO end")
+			So(HTML2TextWithOptions(`<small>© 2024 Acme, Inc.</small> <small>tax excluded</small> <small>Café</small>`, WithSuperSubscripts()),
+				ShouldEqual, "© 2024 Aᴄᴍᴇ, Iɴᴄ. tax excluded Café")
+			So(HTML2Text(`<small>fine print</small>`), ShouldEqual, "fine print")
+			So(HTML2TextWithOptions(`a <br style="font-variant:small-caps"> b <sup/>c`, WithSuperSubscripts()), ShouldEqual, "a\r\nb c")
+		})
+
+		Convey("Quotations", func() {
+			So(HTML2Text(`He said <q>hello <q>world</q></q>.`), ShouldEqual, "He said “hello ‘world’”.")
+			So(HTML2Text(`<div lang="de">Er sagte <q>Hallo</q> und <p lang="en-GB"><q>bye</q></p></div>`), ShouldEqual, "Er sagte „Hallo“ und\r\n\r\n“bye”")
+			So(HTML2Text(`<html lang="fr"><body>Il a dit <q>bonjour <q lang="de">Welt</q></q></body></html>`), ShouldEqual, "Il a dit «\u202Fbonjour ‚Welt‘\u202F»")
+			So(HTML2Text(`<p lang="ja"><q>こんにちは</q></p><q></q>`), ShouldEqual, "「こんにちは」")
+			So(HTML2TextWithOptions(`<q lang="de">a <q>b</q></q>`, WithASCIIQuotes()), ShouldEqual, "\"a 'b'\"")
+			So(HTML2Text(`a <q/>b <q>c</q>`), ShouldEqual, "a b “c”")
+		})
+
+		Convey("Abbreviations and titles", func() {
+			html := `<abbr title="HyperText Markup Language">HTML</abbr> and <acronym title="Cascading Style Sheets">CSS</acronym>, <abbr title="HyperText Markup Language">HTML</abbr> again <span title="tooltip">hover</span>`
+			So(HTML2Text(html), ShouldEqual, "HTML and CSS, HTML again hover")
+			So(HTML2TextWithOptions(html, WithAbbreviations(AbbrInline)),
+				ShouldEqual, "HTML (HyperText Markup Language) and CSS (Cascading Style Sheets), HTML again hover")
+			So(HTML2TextWithOptions(html, WithAbbreviations(AbbrFootnotes), WithUnixLineBreaks()),
+				ShouldEqual, "HTML[1] and CSS[2], HTML again hover\n\n[1] HyperText Markup Language\n[2] Cascading Style Sheets")
+			So(HTML2TextWithOptions(html, WithTitleAttributes()), ShouldEqual, "HTML and CSS, HTML again hover (tooltip)")
+			So(HTML2TextWithOptions(html, WithAbbreviations(AbbrFootnotes), WithTitleAttributes(), WithUnixLineBreaks()),
+				ShouldEqual, "HTML[1] and CSS[2], HTML again hover[3]\n\n[1] HyperText Markup Language\n[2] Cascading Style Sheets\n[3] tooltip")
+			So(HTML2TextWithOptions(`<abbr title="x"> </abbr><abbr>WWW</abbr>`, WithAbbreviations(AbbrInline)), ShouldEqual, "WWW")
+			So(HTML2TextWithOptions(`a <img src="x.png" title="pic"> b <b>c</b> d`, WithTitleAttributes()), ShouldEqual, "a b c d")
+			So(HTML2TextWithOptions(`<p>x<span title="t"/>y</p>z`, WithTitleAttributes()), ShouldEqual, "xy\r\n\r\nz")
+			// the expansion is written as text, so it is sanitized as well
+			So(HTML2TextWithOptions("<span title=\"evil\u202Eexe.txt\">file</span> <abbr title=\"a\u2066b\">AB</abbr>", WithTitleAttributes(), WithAbbreviations(AbbrInline), WithBidiSanitizing()),
+				ShouldEqual, "file (evilexe.txt) AB (ab)")
+			So(HTML2TextWithOptions(`<p><abbr title="Hyper Text">HT</abbr></p>after`, WithAbbreviations(AbbrInline)), ShouldEqual, "HT (Hyper Text)\r\n\r\nafter")
+		})
+
+		Convey("Ruby annotations", func() {
+			html := `<ruby>漢字<rt>かんじ</rt></ruby>を<ruby>読<rp>(</rp><rt>よ</rt><rp>)</rp></ruby>む`
+			So(HTML2Text(html), ShouldEqual, "漢字(かんじ)を読(よ)む")
+			So(HTML2TextWithOptions(html, WithRubyMode(RubyBase)), ShouldEqual, "漢字を読む")
+			So(HTML2TextWithOptions(html, WithRubyMode(RubyAnnotations)), ShouldEqual, "かんじをよむ")
+			So(HTML2Text(`<ruby>漢<rt>かん<rb>字<rt>じ</ruby>`), ShouldEqual, "漢(かん)字(じ)")
+			So(HTML2TextWithOptions(`<ruby>漢<rp>(<rt>かん<rp>)</ruby>`, WithRubyMode(RubyAnnotations)), ShouldEqual, "かん")
+			// annotations are shown only where the ruby hides them
+			So(HTML2TextWithOptions(`<html><head><title>t</title><rt>stray</rt></head><body>body <rt>x</rt></body></html>`, WithRubyMode(RubyAnnotations)), ShouldEqual, "body x")
+			So(HTML2TextWithOptions(`<div style="display:none"><ruby>漢<rt>かん</rt></ruby></div>after`, WithRubyMode(RubyAnnotations), WithCSS()), ShouldEqual, "after")
+		})
+
+		Convey("Bidirectional text", func() {
+			html := `<p dir="rtl">שלום <bdi>user1</bdi></p><p>name: <span dir="auto">محمد</span> <bdo dir="rtl">abc</bdo> <span dir="ltr"> </span></p>`
+			So(HTML2Text(html), ShouldEqual, "שלום user1\r\n\r\nname: محمد abc")
+			So(HTML2TextWithOptions(html, WithBidiIsolates()),
+				ShouldEqual, "\u2067שלום \u2068user1\u2069\u2069\r\n\r\nname: \u2068محمد\u2069 \u2067\u202Eabc\u202C\u2069")
+			So(HTML2TextWithOptions("access\u202E \u2066// check&#x202E; &#8294;admin", WithBidiSanitizing()), ShouldEqual, "access // check admin")
+			So(HTML2TextWithOptions("<bdi>\u202Eevil</bdi>", WithBidiSanitizing(), WithBidiIsolates()), ShouldEqual, "\u2068evil\u2069")
+			So(HTML2TextWithOptions(`a <img dir="rtl" alt="x"> b <br dir="ltr">c`, WithBidiIsolates()), ShouldEqual, "a b\r\nc")
 		})
 
 		Convey("Line breaks and spaces", func() {
@@ -60,6 +140,150 @@ func TestHTML2Text(t *testing.T) {
 			So(HTML2Text(`<p>two</p><p>paragraphs</p>`), ShouldEqual, "two\r\n\r\nparagraphs")
 		})
 
+		Convey("Block elements", func() {
+			So(HTML2Text(`<div>a</div><div>b</div>`), ShouldEqual, "a\r\nb")
+			So(HTML2Text(`some <div id="a" class="b">div</div>`), ShouldEqual, "some\r\ndiv")
+			So(HTML2Text(`<div> <div>nested</div> </div> text`), ShouldEqual, "nested\r\ntext")
+			So(HTML2Text(`<div>a<br></div><div>b</div>`), ShouldEqual, "a\r\nb")
+			So(HTML2Text(`<div>a</div><p>b</p><section>c</section>`), ShouldEqual, "a\r\n\r\nb\r\n\r\nc")
+			So(HTML2Text(`<header>Head</header><main><article><h1>Title</h1><p>body</p></article></main><footer>foot</footer>`),
+				ShouldEqual, "Head\r\n\r\nTitle\r\n\r\nbody\r\n\r\nfoot")
+			So(HTML2Text(`<nav>menu</nav><aside>side</aside><address>addr</address><form>form</form>`), ShouldEqual, "menu\r\nside\r\naddr\r\nform")
+			So(HTML2Text(`<table><tr><td>a</td><td>b</td></tr><tr><th>c</th><th>d</th></tr></table>`), ShouldEqual, "a b\r\nc d")
+			So(HTML2Text(`<p class="x">a</p><p>b</p>`), ShouldEqual, "a\r\n\r\nb")
+		})
+
+		Convey("Horizontal rules", func() {
+			So(HTML2Text(`above<hr>below`), ShouldEqual, "above\r\nbelow")
+			So(HTML2TextWithOptions(`above<hr/>below`, WithHorizontalRule("* * *")), ShouldEqual, "above\r\n* * *\r\nbelow")
+			So(HTML2TextWithOptions(`<p>above</p><hr><p>below</p>`, WithHorizontalRule("-----")), ShouldEqual, "above\r\n\r\n-----\r\n\r\nbelow")
+			So(HTML2TextWithOptions(`above<hr>below`, WithMarkdown(), WithUnixLineBreaks()), ShouldEqual, "above\n\n---\n\nbelow")
+			So(HTML2TextWithOptions(`above<hr class="x">`, WithMarkdown(), WithUnixLineBreaks()), ShouldEqual, "above\n\n---")
+		})
+
+		Convey("Whitespace policy", func() {
+			html := "a&nbsp;b\u00a0c soft&shy;hy\u00adphen long<wbr>word zero\u200bwidth&#x200B;x \u2009thin&thinsp;space family \U0001F468\u200d\U0001F469&zwj;\U0001F467 a\u200db"
+			So(HTML2TextWithOptions(html, WithWhitespacePolicy(WhitespacePolicy{})),
+				ShouldEqual, "a\u00a0b\u00a0c softhyphen longword zerowidthx thin space family \U0001F468\u200d\U0001F469\u200d\U0001F467 ab")
+			So(HTML2TextWithOptions(html, WithWhitespacePolicy(WhitespacePolicy{NBSPAsSpace: true, SoftHyphenBreaks: true})),
+				ShouldEqual, "a b c soft\u00adhy\u00adphen long\u200bword zerowidthx thin space family \U0001F468\u200d\U0001F469\u200d\U0001F467 ab")
+			So(HTML2TextWithOptions("<p>\u200b</p><p>&zwj;text</p>", WithWhitespacePolicy(WhitespacePolicy{})), ShouldEqual, "text")
+		})
+
+		Convey("Line normalization", func() {
+			So(HTML2TextWithOptions(`list of items<ul><li>One</li><li>Two</li><li>Three</li></ul>`, WithLineNormalization(1)), ShouldEqual, "list of items\r\nOne\r\nTwo\r\nThree")
+			So(HTML2TextWithOptions(`<br><br>a &nbsp;<br>&#9;<br><br><br><br>b&#13;&#10;c&#13;d&#10;e<br><br>`, WithLineNormalization(1), WithUnixLineBreaks()), ShouldEqual, "a\n\nb\nc\nd\ne")
+			So(HTML2TextWithOptions(`a<br><br><br><br>b`, WithLineNormalization(2)), ShouldEqual, "a\r\n\r\n\r\nb")
+			So(HTML2TextWithOptions(`a<br><br><br><br>b`, WithLineNormalization(0)), ShouldEqual, "a\r\nb")
+			So(HTML2TextWithOptions(`a<br><br><br><br>b`, WithLineNormalization(-1)), ShouldEqual, "a\r\n\r\n\r\n\r\nb")
+		})
+
+		Convey("CSS styles", func() {
+			So(HTML2TextWithOptions(`a<span style="display:none">hidden</span> b<p style="visibility: hidden">gone</p><p>c</p>`, WithCSS()), ShouldEqual, "a b\r\n\r\nc")
+			So(HTML2TextWithOptions(`<div style="display:inline">one</div><div style="display:inline">two</div><span style="display:block">three</span>four`, WithCSS()), ShouldEqual, "onetwo\r\nthree\r\nfour")
+			So(HTML2TextWithOptions(`<span style="text-transform: uppercase">shout</span> <span style="text-transform:capitalize">hello wide world</span>`, WithCSS()), ShouldEqual, "SHOUT Hello Wide World")
+			So(HTML2TextWithOptions(`<p style="text-transform:capitalize">hello (world) "quoted" don't re-use 2nd</p>`, WithCSS()), ShouldEqual, `Hello (World) "Quoted" Don't Re-Use 2nd`)
+			So(HTML2TextWithOptions("<pre>\n  a  b\n\tc\n</pre>after", WithCSS(), WithUnixLineBreaks()), ShouldEqual, "  a  b\n\tc\nafter")
+			So(HTML2TextWithOptions("<p style=\"white-space:pre-line\">a  b\nc</p>", WithCSS(), WithUnixLineBreaks()), ShouldEqual, "a b\nc")
+			So(HTML2TextWithOptions(`<style>/* rules */ .x{display:none} p.up { text-transform: uppercase } #m { display: none !important } @media print { p { display: none } }</style>`+
+				`<div class="a x">no</div><p class="up">shout</p><b id="m">no</b><p>quiet</p>`, WithCSS()), ShouldEqual, "SHOUT\r\n\r\nquiet")
+			So(HTML2TextWithOptions(`<style>p { display: none } .show { display: block }</style><p class="show">shown</p><p>hidden</p>`, WithCSS()), ShouldEqual, "shown")
+			So(HTML2TextWithOptions(`<style>.x{display:none}</style><p class="x" style="display:block">inline wins</p>`, WithCSS()), ShouldEqual, "inline wins")
+			So(HTML2Text(`<span style="display:none">shown without WithCSS</span>`), ShouldEqual, "shown without WithCSS")
+
+			// elements with optional end tags are closed by their siblings
+			So(HTML2TextWithOptions(`<ul><li style="display:none">a<li>b</ul>after`, WithCSS()), ShouldEqual, "b\r\nafter")
+			So(HTML2TextWithOptions(`<style>.x{display:none}</style><p class=x>a<p>b<div>c</div>`, WithCSS()), ShouldEqual, "b\r\nc")
+			So(HTML2TextWithOptions(`<p style="text-transform:uppercase">a<p>b<p>c`, WithCSS()), ShouldEqual, "A\r\n\r\nb\r\n\r\nc")
+			So(HTML2TextWithOptions(`<dl><dt style="display:none">t<dd>d<dt>u</dl>`, WithCSS()), ShouldEqual, "    d\r\nu")
+			So(HTML2TextWithOptions(`<table><tr style="display:none"><td>a<tr><td style="text-transform:uppercase">b<td>c</table>`, WithCSS()), ShouldEqual, "B c")
+			So(HTML2TextWithOptions(`<ul><li>a<ul><li style="display:none">x</ul>b<li>c</ul>`, WithCSS()), ShouldEqual, "a\r\nb\r\nc\r\n")
+		})
+
+		Convey("Form controls", func() {
+			So(HTML2TextWithOptions(`<label><input type="checkbox" checked> Subscribe</label><br><label><input type=checkbox>Spam me</label>`, WithFormControls()), ShouldEqual, "[x] Subscribe\r\n[ ] Spam me")
+			So(HTML2TextWithOptions(`<input type=radio name=a checked>Yes <input type=radio name=a>No`, WithFormControls()), ShouldEqual, "(x) Yes ( ) No")
+			So(HTML2TextWithOptions(`Name: <input type="text" value="Tom &amp; Jerry"><input type="hidden" name="csrf" value="secret"> Password: <input type=password value=abc> <input placeholder="Email">`, WithFormControls()),
+				ShouldEqual, "Name: [Tom & Jerry] Password: [***] [Email]")
+			So(HTML2TextWithOptions(`Color: <select><option>Red<option selected>Green</option><option>Blue</select> <select><option>First<option>Second</select>`, WithFormControls()), ShouldEqual, "Color: [Green] [First]")
+			So(HTML2TextWithOptions(`<select multiple><optgroup label=x><option selected>A<option>B<option selected label="Cee">C</optgroup></select>`, WithFormControls()), ShouldEqual, "[A, Cee]")
+			// a select ends where the next one starts, unclosed selects do not look ahead to the end
+			So(HTML2TextWithOptions(`<select><option>a<select><option>b<option selected>c</select> d`, WithFormControls()), ShouldEqual, "[a][c] d")
+			So(HTML2TextWithOptions(`<form><select><option>a<option selected>b</form><select><option selected>c</select>`, WithFormControls()), ShouldEqual, "[b]\r\n[c]")
+			So(HTML2TextWithOptions(strings.Repeat("<select><option>a", 5000), WithFormControls()), ShouldEqual, strings.Repeat("[a]", 5000))
+			So(HTML2TextWithOptions(`<textarea>Hello there</textarea> <textarea></textarea> <button>Send <b>now</b></button> <input type=submit> <input type=image alt=Go>`, WithFormControls()),
+				ShouldEqual, "[Hello there] [] [Send now] [Submit] [Go]")
+			So(HTML2Text(`Color: <select><option>Red<option selected>Green</select><input type=checkbox checked>`), ShouldEqual, "Color: RedGreen")
+		})
+
+		Convey("Details and dialogs", func() {
+			So(HTML2Text(`before<details><summary>More</summary><p>Hidden text</p>second line</details>after`), ShouldEqual, "before\r\n▸ More\r\n\r\n  Hidden text\r\n\r\n  second line\r\nafter")
+			So(HTML2TextWithOptions("<details open>\n<summary>Shown <b>label</b></summary>\nBody<details><summary>Nested</summary>Inner</details></details>", WithUnixLineBreaks()),
+				ShouldEqual, "▾ Shown label\n  Body\n  ▸ Nested\n    Inner")
+			So(HTML2TextWithOptions("<details open>\n<summary>Shown</summary>\nBody<details><summary>Nested</summary>Inner</details></details>", WithUnixLineBreaks(), WithOpenDetailsOnly()),
+				ShouldEqual, "▾ Shown\n  Body\n  ▸ Nested")
+			So(HTML2Text(`<details>No summary</details>`), ShouldEqual, "▸ Details\r\n  No summary")
+			// an unclosed <dd> does not leak its indentation out of the details
+			So(HTML2Text(`<details><summary>S<dl><dt>T<dd>d</details>after`), ShouldEqual, "▸ S\r\nT\r\n    d\r\nafter")
+			So(HTML2Text(`<details><summary>S</summary><dl><dt>T<dd>d</details>after`), ShouldEqual, "▸ S\r\n  T\r\n      d\r\nafter")
+			So(HTML2Text(`<dl><dt>A<dd><details><summary>S</summary><dl><dt>T<dd>d</details>e</dl>after`), ShouldEqual, "A\r\n    ▸ S\r\n      T\r\n          d\r\n    e\r\nafter")
+			So(HTML2Text(`<dialog>Closed dialog</dialog><dialog open>Open dialog</dialog>`), ShouldEqual, "Closed dialog\r\nOpen dialog")
+			So(HTML2TextWithOptions(`<dialog>Closed dialog</dialog><dialog open>Open dialog</dialog>`, WithOpenDetailsOnly()), ShouldEqual, "Open dialog")
+		})
+
+		Convey("Figures and media", func() {
+			So(HTML2Text(`text<figure><img src="a.png" alt="A chart"><figcaption>Sales in 2017</figcaption></figure>more`), ShouldEqual, "text\r\n\r\nSales in 2017\r\n\r\nmore")
+			So(HTML2Text(`<video poster="p.jpg">Your client does not support video.</video>`), ShouldEqual, "Your client does not support video.")
+			So(HTML2TextWithOptions(`text<figure><img src="a.png" alt="A chart"><figcaption>Sales in 2017</figcaption></figure>more`, WithMediaPlaceholders()),
+				ShouldEqual, "text\r\n\r\n[Image: A chart]\r\nSales in 2017\r\n\r\nmore")
+			So(HTML2TextWithOptions(`<video title="Product tour"><source src="tour.webm">Your client does not support video.</video>`, WithMediaPlaceholders()), ShouldEqual, "[Video: Product tour]")
+			So(HTML2TextWithOptions(`<video title="Product tour"><source src="tour.webm">Your client does not support video.</video>`, WithMediaPlaceholders(), WithLinksInnerText()),
+				ShouldEqual, "[Video: Product tour] <tour.webm>")
+			So(HTML2TextWithOptions(`<video poster="p.jpg"></video> <audio>No audio</audio> <iframe src="https://example.com/map" title=Map>Frames needed</iframe> <object data="x.swf">fallback</object><img src=pixel.gif>`, WithMediaPlaceholders(), WithLinksInnerText()),
+				ShouldEqual, "[Video] <p.jpg> [Audio] [Frame: Map] <https://example.com/map> [Object] <x.swf>")
+			So(HTML2TextWithOptions(`<picture><source srcset=a.webp><img src=a.jpg alt=Cat></picture>`, WithMediaPlaceholders()), ShouldEqual, "[Image: Cat]")
+		})
+
+		Convey("SVG and MathML", func() {
+			So(HTML2Text(`Click <svg aria-label="Search icon"><text>junk</text></svg> or <svg><title>Logo &amp; name</title><text x=1>junk</text></svg><svg><text>removed</text></svg>!`),
+				ShouldEqual, "Click Search icon or Logo & name!")
+
+			quadratic := `<math display="block"><semantics><mrow><mi>x</mi><mo>=</mo><mfrac><mrow><mo>&minus;</mo><mi>b</mi><mo>&PlusMinus;</mo>` +
+				`<msqrt><msup><mi>b</mi><mn>2</mn></msup><mo>&minus;</mo><mn>4</mn><mi>a</mi><mi>c</mi></msqrt></mrow><mrow><mn>2</mn><mi>a</mi></mrow></mfrac></mrow>` +
+				`<annotation encoding="application/x-tex">x = \frac{-b \pm \sqrt{b^2-4ac}}{2a}</annotation></semantics></math>`
+			So(HTML2Text("The formula"+quadratic+"solves it."), ShouldEqual, "The formula\r\nx = (−b ± √(b² − 4ac)) / 2a\r\nsolves it.")
+			So(HTML2TextWithOptions(quadratic, WithMathAnnotations()), ShouldEqual, `x = \frac{-b \pm \sqrt{b^2-4ac}}{2a}`)
+			So(HTML2Text(`Area <math><mi>π</mi><msup><mi>r</mi><mn>2</mn></msup></math>, <math><mi>sin</mi><mo>&ApplyFunction;</mo><mo>(</mo><mi>x</mi><mo>+</mo><mn>1</mn><mo>)</mo></math>`),
+				ShouldEqual, "Area πr², sin(x + 1)")
+			So(HTML2Text(`<math><msub><mi>a</mi><mrow><mi>n</mi><mo>-</mo><mn>1</mn></mrow></msub><mo>,</mo><mroot><mi>x</mi><mn>3</mn></mroot><mo>,</mo><mfenced><mi>a</mi><mi>b</mi></mfenced><mo>,</mo><msup><mi>e</mi><mi>β</mi></msup></math>`),
+				ShouldEqual, "aₙ₋₁, ³√x, (a, b), e^β")
+			So(HTML2Text(`<math alttext="x squared"></math>`), ShouldEqual, "x squared")
+			// a nested formula ends at the end tag of its own level
+			So(HTML2Text(`<math><mi>x</mi><math><mi>y</mi></math><mo>+</mo><mn>1</mn></math> after`), ShouldEqual, "xy + 1 after")
+			So(HTML2Text(`<svg><svg><text>a</text></svg><title>Outer</title></svg>`), ShouldEqual, "Outer")
+			// unclosed formulas and images are not parsed again for each start tag
+			So(HTML2Text(strings.Repeat("<math><mi>a</mi>", 5000)), ShouldEqual, strings.Repeat("a", 5000))
+			So(HTML2Text(strings.Repeat("<svg><text>a</text>", 5000)+"<p>end"), ShouldEqual, "")
+		})
+
+		Convey("ARIA roles and labels", func() {
+			So(HTML2TextWithOptions(`<nav aria-label="Main menu"><a href="/" aria-label="Home page">⌂</a> <a href="/about">About</a></nav>`+
+				`<main><div role="heading" aria-level="1">Title</div><p>Text <span aria-hidden="true">★★★</span>rated</p>`+
+				`<div role="list"><div role="listitem">One</div><div role="listitem">Two</div></div></main><footer>(c) 2024</footer>`, WithARIA(), WithLinksInnerText(), WithListSupportPrefix("* ")),
+				ShouldEqual, "Navigation (Main menu): Home page </> About </about>\r\n\r\nMain: Title\r\n\r\nText rated\r\n\r\n* One\r\n* Two\r\n\r\nContent info: (c) 2024")
+			So(HTML2TextWithOptions(`<span id="lbl">Close dialog</span> <button aria-labelledby="lbl">X</button><section>no region</section><section aria-label="News">news</section><nav></nav>`, WithARIA()),
+				ShouldEqual, "Close dialog Close dialog\r\nno region\r\nRegion (News): news")
+			// the end tag of a referenced <b> is not </button>
+			So(HTML2TextWithOptions(`<b id="n">Name <button>inner</button> more</b> <button aria-labelledby="n">x</button>`, WithARIA()),
+				ShouldEqual, "Name inner more Name inner more")
+			// many references to the same and to different elements
+			labelled := strings.Repeat(`<span id="a">Save</span> <span id="b">draft</span> <button aria-labelledby="a b">S</button> <button aria-labelledby="b missing">D</button> `, 500)
+			So(HTML2TextWithOptions(strings.TrimSpace(labelled), WithARIA()), ShouldEqual, strings.TrimSpace(strings.Repeat("Save draft Save draft draft ", 500)))
+			So(HTML2TextWithOptions(`<article><header>not a banner</header></article><div aria-label="ignored">div text</div><h2 aria-label="Real">Visual</h2>`, WithARIA()),
+				ShouldEqual, "not a banner\r\ndiv text\r\n\r\nReal")
+			So(HTML2Text(`<nav><h2 aria-label="Real">Visual</h2><span aria-hidden="true">shown</span></nav>`), ShouldEqual, "Visual\r\n\r\nshown")
+		})
+
 		Convey("Headings", func() {
 			So(HTML2Text("<h1>First</h1>main text"), ShouldEqual, "First\r\n\r\nmain text")
 			So(HTML2Text("First<h2>Second</h2>next section"), ShouldEqual, "First\r\n\r\nSecond\r\n\r\nnext section")
@@ -75,6 +299,42 @@ func TestHTML2Text(t *testing.T) {
 			So(HTML2Text("<h7>Not Header</h7>next section"), ShouldEqual, "Not Headernext section")
 		})
 
+		Convey("Heading styles", func() {
+			So(HTML2TextWithOptions("<h1>First</h1><h2>Second</h2>text", WithHeadingStyle(HeadingUnderline), WithUnixLineBreaks()),
+				ShouldEqual, "First\n=====\n\nSecond\n------\n\ntext")
+			So(HTML2TextWithOptions("<h1>日本語 é</h1>", WithHeadingStyle(HeadingUnderline), WithUnixLineBreaks()),
+				ShouldEqual, "日本語 é\n========")
+			So(HTML2TextWithOptions("<h1>a<br>bc</h1>", WithHeadingStyle(HeadingUnderline)), ShouldEqual, "a\r\nbc\r\n==")
+			So(HTML2TextWithOptions("<dl><dd><h2>long line<br>b</h2></dl>", WithHeadingStyle(HeadingUnderline), WithUnixLineBreaks()),
+				ShouldEqual, "    long line\n    b\n    -")
+			So(HTML2TextWithOptions("<h1>First</h1><h3>Third</h3>", WithHeadingStyle(HeadingATX), WithUnixLineBreaks()),
+				ShouldEqual, "# First\n\n### Third")
+			So(HTML2TextWithOptions("<h1>First</h1><h2>Second</h2>", WithMarkdown(), WithUnixLineBreaks()),
+				ShouldEqual, "# First\n\n## Second")
+			So(HTML2TextWithOptions("<h1>Fïrst <b>bold</b></h1><h2>Second</h2>", WithHeadingStyle(HeadingUppercase|HeadingUnderline, 1), WithUnixLineBreaks()),
+				ShouldEqual, "FÏRST BOLD\n==========\n\nSecond")
+			So(HTML2TextWithOptions("<h1></h1><h2> </h2>text", WithHeadingStyle(HeadingATX|HeadingUnderline), WithUnixLineBreaks()),
+				ShouldEqual, "text")
+			So(HTML2TextWithOptions("<h1>A</h1><h2>B</h2><h3>C</h3><h2>D</h2><h1>E</h1><h3>F</h3>", WithHeadingNumbers(), WithUnixLineBreaks()),
+				ShouldEqual, "1 A\n\n1.1 B\n\n1.1.1 C\n\n1.2 D\n\n2 E\n\n2.0.1 F")
+			So(HTML2TextWithOptions("<h2>A</h2><h3>B</h3><h2>C</h2>", WithHeadingNumbers(), WithHeadingStyle(HeadingATX), WithUnixLineBreaks()),
+				ShouldEqual, "## 1 A\n\n### 1.1 B\n\n## 2 C")
+		})
+
+		Convey("Definition lists", func() {
+			So(HTML2TextWithOptions(`<dl><dt>Term</dt><dd>Definition</dd><dt>Second</dt><dd>one</dd><dd>two<br>lines</dd></dl>after`, WithUnixLineBreaks()),
+				ShouldEqual, "Term\n    Definition\nSecond\n    one\n    two\n    lines\nafter")
+			So(HTML2TextWithOptions(`before<dl><dt>A<dd>a<dt>B<dd>b<dl><dt>N<dd>nested</dl></dl>after`, WithUnixLineBreaks()),
+				ShouldEqual, "before\nA\n    a\nB\n    b\n    N\n        nested\nafter")
+			// definitions are ended by the end of their parent as well
+			So(HTML2TextWithOptions(`<div><dl><dt>T<dd>d</div>after<dl><dd>x</dd></dd>y</dl>`, WithUnixLineBreaks()),
+				ShouldEqual, "T\n    d\nafter\n    x\ny")
+			So(HTML2TextWithOptions(`<dl><dt>Term <dt> </dt><dd>Definition</dd></dl>`, WithMarkdown(), WithUnixLineBreaks()),
+				ShouldEqual, "**Term**\n\n: Definition")
+			So(HTML2TextWithOptions(`before<dl><dt>A<dd>a<dt>B<dd>b<dd>c</dl>after`, WithMarkdown(), WithUnixLineBreaks()),
+				ShouldEqual, "before\n\n**A**\n\n: a\n\n**B**\n\n: b\n\n: c\n\nafter")
+		})
+
 		Convey("HTML entities", func() {
 			So(HTML2Text(`two&nbsp;&nbsp;spaces`), ShouldEqual, "two  spaces")
 			So(HTML2Text(`&copy; 2017 K3A`), ShouldEqual, "© 2017 K3A")
@@ -96,15 +356,143 @@ func TestHTML2Text(t *testing.T) {
 
 		Convey("Numeric HTML Entities", func() {
 			So(HTMLEntitiesToText("&#39;single quotes&#39; and &#52765;"), ShouldEqual, "'single quotes' and 츝")
+			So(HTMLEntitiesToText("&#150; and &#x93;quoted&#x94;"), ShouldEqual, "– and “quoted”")
+			So(HTMLEntitiesToText("&#x81; stays unmapped"), ShouldEqual, "\u0081 stays unmapped")
+			So(HTMLEntitiesToText("nul &#0; surrogate &#xD800; out of range &#x110000;"), ShouldEqual, "nul \uFFFD surrogate \uFFFD out of range \uFFFD")
+			So(HTMLEntitiesToText("&#999999999; &#xFFFFFFFF;"), ShouldEqual, "\uFFFD \uFFFD")
+			So(HTMLEntitiesToText("&#1; control"), ShouldEqual, "&#1; control")
+		})
+
+		Convey("Multi-codepoint HTML entities", func() {
+			So(HTML2Text(`a &NotEqualTilde; b`), ShouldEqual, "a \u2242\u0338 b")
+			So(HTML2Text(`&nvlt;&bne;&ThickSpace;`), ShouldEqual, "<\u20D2=\u20E5\u205F\u200A")
+			So(HTMLEntitiesToText("&nLt; &nGt;"), ShouldEqual, "\u226A\u20D2 \u226B\u20D2")
+			So(HTMLEntitiesToText("&CounterClockwiseContourIntegral;"), ShouldEqual, "\u2233")
+		})
+
+		Convey("Generated entity table matches entities.json", func() {
+			data, err := os.ReadFile("entities.json")
+			So(err, ShouldBeNil)
+
+			var defs map[string]struct {
+				Characters string `json:"characters"`
+			}
+			So(json.Unmarshal(data, &defs), ShouldBeNil)
+
+			terminated := 0
+			for key, def := range defs {
+				if !strings.HasSuffix(key, ";") {
+					continue
+				}
+				terminated++
+
+				value, ok := lookupEntity(key[1 : len(key)-1])
+				So(ok, ShouldBeTrue)
+				So(value, ShouldEqual, def.Characters)
+				So(HTMLEntitiesToText(key), ShouldEqual, def.Characters)
+			}
+			So(entityCount, ShouldEqual, terminated)
+
+			for i := 1; i < entityCount; i++ {
+				So(entityName(i-1), ShouldBeLessThan, entityName(i))
+			}
 		})
 
 		Convey("Full HTML structure", func() {
 			So(HTML2Text(``), ShouldEqual, "")
 			So(HTML2Text(`<html><head><title>Good</title></head><body>x</body>`), ShouldEqual, "x")
 			So(HTML2Text(`<html><head href="foo"><title>Good</title></head><body>x</body>`), ShouldEqual, "x")
+			So(HTML2Text(`<html><head><title>Tom &amp; Jerry</title></head><body>x</body>`), ShouldEqual, "x")
 			So(HTML2Text(`<htMl><hEad><titLe>Good</Title></head><boDy>x</Body>`), ShouldEqual, "x")
 			So(HTML2Text(`we are not <script type="javascript"></script>interested in scripts`),
 				ShouldEqual, "we are not interested in scripts")
+			So(HTML2Text(`<script>if (a<b && c>d) { x = "</div>" }</script>ok<style>p > a { color: red }</STYLE>`), ShouldEqual, "ok")
+			So(HTML2Text(`<script>x = "</scripts>"</script >ok<style>/* </styles> */</style>`), ShouldEqual, "ok")
+		})
+
+		Convey("Metadata", func() {
+			text, meta := HTML2TextWithMetadata(`<!DOCTYPE html><html lang="en-GB"><head><title> Tom &amp; Jerry </title>` +
+				`<meta name="description" content="A cat &amp; mouse"><meta name="keywords" content="cat, mouse,,cartoon"><meta name="author" content="Hanna">` +
+				`<link rel="canonical" href="https://example.com/tj"><meta property="og:title" content="TJ"><meta property="og:image" content="a.png"><meta property="og:image" content="b.png">` +
+				`<meta name="twitter:card" content="summary"><script type="application/ld+json">{"@type": "Article", "name": "<b>"}</script></head>` +
+				`<body><svg><title>icon</title></svg> Hello</body></html>`)
+			So(text, ShouldEqual, "icon Hello")
+			So(meta.Title, ShouldEqual, "Tom & Jerry")
+			So(meta.Description, ShouldEqual, "A cat & mouse")
+			So(meta.Keywords, ShouldResemble, []string{"cat", "mouse", "cartoon"})
+			So(meta.Author, ShouldEqual, "Hanna")
+			So(meta.Lang, ShouldEqual, "en-GB")
+			So(meta.Canonical, ShouldEqual, "https://example.com/tj")
+			So(meta.OpenGraph, ShouldResemble, map[string][]string{"title": {"TJ"}, "image": {"a.png", "b.png"}})
+			So(meta.Twitter, ShouldResemble, map[string][]string{"card": {"summary"}})
+			So(meta.JSONLD, ShouldHaveLength, 1)
+
+			var ld map[string]string
+			So(json.Unmarshal([]byte(meta.JSONLD[0]), &ld), ShouldBeNil)
+			So(ld["name"], ShouldEqual, "<b>")
+
+			text, meta = HTML2TextWithMetadata(`plain`)
+			So(text, ShouldEqual, "plain")
+			So(meta, ShouldResemble, Metadata{})
+
+			// only the first title is read, the lookahead does not repeat for unclosed titles
+			_, meta = HTML2TextWithMetadata(`<title>First</title><title>Second</title>`)
+			So(meta.Title, ShouldEqual, "First")
+			_, meta = HTML2TextWithMetadata(strings.Repeat("<title>a", 5000) + "</titles>")
+			So(meta.Title, ShouldEqual, "")
+		})
+
+		Convey("Link inventory", func() {
+			html := `<p>Log in at <a href="https://paypal.com.evil.io/login" title="PayPal">www.paypal.com</a> or <a href="https://mail.example.com/x" rel="nofollow">example.com</a>.</p>` +
+				`<div style="display:none"><a href="http://hidden.test">hidden</a></div><br><br><br><a href="mailto:bob@example.org">bob@example.org</a> ` +
+				`<a href="/account">https://bank.com/</a> <a name="top">anchor</a>`
+
+			text, links := HTML2TextWithLinks(html, WithLinksInnerText(), WithCSS(), WithLineNormalization(0))
+			So(text, ShouldEqual, "Log in at www.paypal.com <https://paypal.com.evil.io/login> or example.com <https://mail.example.com/x>.\r\nbob@example.org <mailto:bob@example.org> https://bank.com/ </account>")
+			So(links, ShouldResemble, []Link{
+				{Text: "www.paypal.com", Href: "https://paypal.com.evil.io/login", Title: "PayPal", Offset: 10, Mismatch: true},
+				{Text: "example.com", Href: "https://mail.example.com/x", Rel: "nofollow", Offset: 63},
+				{Href: "http://hidden.test", Offset: -1},
+				{Text: "bob@example.org", Href: "mailto:bob@example.org", Offset: 106},
+				{Text: "https://bank.com/", Href: "/account", Offset: 147, Mismatch: true},
+			})
+			for _, l := range links {
+				if l.Offset >= 0 {
+					So(strings.HasPrefix(text[l.Offset:], l.Text), ShouldBeTrue)
+				}
+			}
+
+			text, links = HTML2TextWithLinks(`see <a href="http://example.com/">here</a>`)
+			So(text, ShouldEqual, "see http://example.com/")
+			So(links, ShouldResemble, []Link{{Text: "here", Href: "http://example.com/", Offset: 4}})
+
+			// the text is what the converter writes, up to the end tag of the link only
+			phishing := `<a href="https://evil.io/"><abbr title="x">www.</abbr>paypal.com</a>`
+			text, links = HTML2TextWithLinks(phishing)
+			So(text, ShouldEqual, "https://evil.io/")
+			So(links, ShouldResemble, []Link{{Text: "www.paypal.com", Href: "https://evil.io/", Offset: 0, Mismatch: true}})
+			text, links = HTML2TextWithLinks(phishing, WithLinksInnerText(), WithAbbreviations(AbbrInline))
+			So(text, ShouldEqual, "www. (x)paypal.com <https://evil.io/>")
+			So(links, ShouldResemble, []Link{{Text: "www.paypal.com", Href: "https://evil.io/", Offset: 0, Mismatch: true}})
+			_, links = HTML2TextWithLinks(`<a href="https://a.test/">two<br>lines<span style="display:none">.evil.io</span></a>`, WithCSS())
+			So(links, ShouldResemble, []Link{{Text: "two lines", Href: "https://a.test/", Offset: 0}})
+
+			// email addresses are compared by their domain
+			_, links = HTML2TextWithLinks(`<a href="https://evil.io/">support@paypal.com</a> <a href="mailto:Support@PayPal.com">support@paypal.com</a>`)
+			So(links, ShouldResemble, []Link{
+				{Text: "support@paypal.com", Href: "https://evil.io/", Offset: 0, Mismatch: true},
+				{Text: "support@paypal.com", Href: "mailto:Support@PayPal.com", Offset: 17},
+			})
+
+			// offsets point to the link in the transformed text
+			text, links = HTML2TextWithLinks(`<a href="https://a.test/">x<sup>2</sup></a> see<sup><a href="#n">[1]</a></sup> `+
+				`<span style="text-transform:uppercase">ıı <a href="https://c.test/">c.test</a></span>`, WithSuperSubscripts(), WithCSS(), WithLinksInnerText())
+			So(text, ShouldEqual, "x² <https://a.test/> see^([1] <#n>) II C.TEST <HTTPS://C.TEST/>")
+			So(links, ShouldResemble, []Link{
+				{Text: "x2", Href: "https://a.test/", Offset: 0},
+				{Text: "[1]", Href: "#n", Offset: 27},
+				{Text: "c.test", Href: "https://c.test/", Offset: 40},
+			})
 		})
 
 		Convey("Switching Unix and Windows line breaks (original behavior)", func() {
@@ -132,6 +520,52 @@ func TestHTML2Text(t *testing.T) {
 			So(HTML2TextWithOptions(`list of items<ol><li>One</li><li>Two</li><li>Three</li></ol>`, WithListSupport()), ShouldEqual, "list of items\r\n - One\r\n - Two\r\n - Three\r\n")
 		})
 
+		Convey("Text to HTML", func() {
+			So(EscapeHTML(`<a href="x">Tom & Jerry's</a>`), ShouldEqual, "&lt;a href=&quot;x&quot;&gt;Tom &amp; Jerry&#39;s&lt;/a&gt;")
+			So(EscapeHTML(`© 2017 K3A, 5 €`), ShouldEqual, "© 2017 K3A, 5 €")
+			So(EscapeHTML(`© 2017 K3A, 5 €, it's`, WithNamedEntities()), ShouldEqual, "&copy; 2017 K3A, 5 &euro;, it&apos;s")
+			So(EscapeHTML(`¨ ± Å … ∈ ⇔`, WithNamedEntities()), ShouldEqual, "&uml; &plusmn; &Aring; &hellip; &isin; &hArr;")
+
+			So(TextToHTML(""), ShouldEqual, "")
+			So(TextToHTML("first\nline\n\nsecond <para>"), ShouldEqual, "<p>first<br>line</p><p>second &lt;para&gt;</p>")
+			So(TextToHTML("a  b\tc"), ShouldEqual, "<p>a &#32;b&#9;c</p>")
+			So(TextToHTML("see http://example.com/?a=1&b=2.", WithAutoLinks()),
+				ShouldEqual, `<p>see <a href="http://example.com/?a=1&amp;b=2">http://example.com/?a=1&amp;b=2</a>.</p>`)
+			So(TextToHTML("(https://example.com)", WithAutoLinks()),
+				ShouldEqual, `<p>(<a href="https://example.com">https://example.com</a>)</p>`)
+
+			for _, text := range []string{
+				"simple text",
+				"Tom & Jerry <3\r\nsecond line",
+				"  indented\r\n\r\nparagraph with  two spaces &amp; entity",
+				"visit https://example.com/?a=1&b=2 now",
+				"trailing space \r\nnext line",
+			} {
+				So(HTML2Text(TextToHTML(text, WithAutoLinks())), ShouldEqual, text)
+				So(HTML2Text(TextToHTML(text, WithNamedEntities())), ShouldEqual, text)
+			}
+		})
+
+		Convey("Charset detection and transcoding", func() {
+			So(HTML2TextBytes([]byte("caf\xc3\xa9")), ShouldEqual, "café")
+			So(HTML2TextBytes([]byte("\xef\xbb\xbfcaf\xc3\xa9")), ShouldEqual, "café")
+			So(HTML2TextBytes([]byte("\xff\xfec\x00a\x00f\x00\xe9\x00")), ShouldEqual, "café")
+			So(HTML2TextBytes([]byte("\xfe\xff\x00c\x00a\x00f\x00\xe9")), ShouldEqual, "café")
+			So(HTML2TextBytes([]byte("<meta charset=\"ISO-8859-1\"><p>caf\xe9 &#150; 5 \x80</p>")), ShouldEqual, "café – 5 €")
+			So(HTML2TextBytes([]byte("<meta http-equiv=\"Content-Type\" content=\"text/html; charset=koi8-r\">\xf0\xd2\xc9\xd7\xc5\xd4")), ShouldEqual, "Привет")
+			So(HTML2TextBytes([]byte("\xcf\xf0\xe8\xe2\xe5\xf2"), WithCharset("windows-1251")), ShouldEqual, "Привет")
+			So(HTML2TextBytes([]byte("<meta charset=\"utf-8\">\xa4"), WithCharset("iso-8859-15")), ShouldEqual, "€")
+
+			sjis := func(charset string, input []byte) (string, bool) {
+				if charset != "shift_jis" {
+					return "", false
+				}
+				return strings.Replace(string(input), "\x93\xfa", "日", -1), true
+			}
+			So(HTML2TextBytes([]byte("<meta charset=\"Shift_JIS\">\x93\xfa"), WithCharsetDecoder(sjis)), ShouldEqual, "日")
+			So(HTML2TextBytes([]byte(`<meta charset="x-unknown">ok`), WithCharsetDecoder(sjis)), ShouldEqual, "ok")
+		})
+
 		Convey("Custom HTML Tags", func() {
 			So(HTML2Text(`<aa>hello</aa>`), ShouldEqual, "hello")
 			So(HTML2Text(`<aa >hello</aa>`), ShouldEqual, "hello")
@@ -0,0 +1,151 @@
+package html2text
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// urlLikeRE matches text which looks like a URL or a domain name, capturing the host
+var urlLikeRE = regexp.MustCompile(`(?i)^(?:(?:https?|ftp)://)?((?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,63})\.?(?::\d+)?(?:[/?#]\S*)?$`)
+
+// emailLikeRE matches text which looks like an email address, capturing the domain
+var emailLikeRE = regexp.MustCompile(`(?i)^(?:mailto:)?[^\s@<>()]+@((?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,63})\.?$`)
+
+// Link is a link of the document, see HTML2TextWithLinks
+type Link struct {
+	Text  string // visible text of the link with collapsed whitespace, empty if the link is hidden
+	Href  string
+	Title string
+	Rel   string
+	// byte offset of the first output of the link in the text, -1 if it has no output
+	Offset int
+	// Mismatch reports that Text looks like a URL, a domain name or an email address
+	// but Href points to a different host, as common in phishing
+	Mismatch bool
+}
+
+// HTML2TextWithLinks converts html into a text form like HTML2TextWithOptions
+// and returns all <a href> links of the document in the order of appearance,
+// including those with no output, e.g. because they are hidden
+func HTML2TextWithLinks(html string, reqOpts ...Option) (string, []Link) {
+	opts := newOptions()
+	for _, opt := range reqOpts {
+		opt(opts)
+	}
+
+	c := newConverter(opts)
+	c.collectLinks = true
+	text := c.convert(html)
+
+	links := make([]Link, len(c.links))
+	for i, l := range c.links {
+		links[i] = *l
+	}
+	return text, links
+}
+
+// openLink is an open <a> whose text is being collected
+type openLink struct {
+	// the text of the link is written at this depth of hidden elements,
+	// one more than outside of it if the converter writes the href instead,
+	// -1 if the link is hidden
+	depth int
+	text  strings.Builder
+}
+
+// linkElement records the opened <a> and collects its text until it is closed
+func (c *converter) linkElement(e *element) {
+	if !c.isOpen(e) || !e.hasAttr("href") {
+		return
+	}
+
+	l := &Link{
+		Href:   strings.TrimSpace(e.attr("href")),
+		Title:  e.attr("title"),
+		Rel:    e.attr("rel"),
+		Offset: -1,
+	}
+	c.links = append(c.links, l)
+	c.pendingLink = l
+
+	ol := &openLink{depth: -1}
+	if !c.hidden() {
+		ol.depth = 0
+		if !c.opts.linksInnerText || !linkTagRE.MatchString(e.tag) {
+			// hidden by convert, the href is written instead
+			ol.depth++
+		}
+	}
+	c.openLinks = append(c.openLinks, ol)
+
+	e.onClose = append(e.onClose, func() {
+		if c.pendingLink == l {
+			c.pendingLink = nil
+		}
+		c.openLinks = c.openLinks[:len(c.openLinks)-1]
+		l.Text = strings.Join(strings.Fields(ol.text.String()), " ")
+		l.Mismatch = isLinkMismatch(l.Text, l.Href)
+	})
+}
+
+// writeLinkText adds the text to the open links it is visible in,
+// it is called for hidden text too as convert hides the text of links
+func (c *converter) writeLinkText(text string) {
+	for _, ol := range c.openLinks {
+		if c.badTagStackDepth == ol.depth {
+			ol.text.WriteString(text)
+		}
+	}
+}
+
+// moveLinkOffsets updates offsets of links in the text written from the start offset
+// which is replaced by transformed. Marker transforms map runes one to one,
+// e.g. to upper case, or wrap the text, e.g. ^(text).
+func (c *converter) moveLinkOffsets(start int, text, transformed string) {
+	sameRunes := utf8.RuneCountInString(text) == utf8.RuneCountInString(transformed)
+	wrapped := strings.Index(transformed, text)
+	for i := len(c.links) - 1; i >= 0; i-- {
+		l := c.links[i]
+		if l.Offset < 0 {
+			continue
+		}
+		rel := l.Offset - start
+		if rel < 0 {
+			break
+		}
+		if sameRunes {
+			rel = len(string([]rune(transformed)[:utf8.RuneCountInString(text[:rel])]))
+		} else if wrapped >= 0 {
+			rel += wrapped
+		}
+		l.Offset = start + rel
+	}
+}
+
+// isLinkMismatch reports whether the text looks like a URL, a domain name or an email
+// address whose host differs from the host of href. Subdomains of the host are accepted.
+func isLinkMismatch(text, href string) bool {
+	m := urlLikeRE.FindStringSubmatch(text)
+	if m == nil {
+		m = emailLikeRE.FindStringSubmatch(text)
+	}
+	if m == nil {
+		return false
+	}
+	textHost := strings.TrimPrefix(strings.ToLower(m[1]), "www.")
+
+	hrefHost := ""
+	if u, err := url.Parse(href); err == nil {
+		hrefHost = u.Hostname()
+		if strings.EqualFold(u.Scheme, "mailto") {
+			if at := strings.LastIndexByte(u.Opaque, '@'); at >= 0 {
+				hrefHost = u.Opaque[at+1:]
+			}
+		}
+	}
+	hrefHost = strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(hrefHost), "."), "www.")
+
+	return hrefHost != textHost && !strings.HasSuffix(hrefHost, "."+textHost)
+}
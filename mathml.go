@@ -0,0 +1,310 @@
+package html2text
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var mathTokenRE = regexp.MustCompile(`<!--[\s\S]*?-->|<(/?)([a-zA-Z][\w:.-]*)([^>]*)>|[^<]+|<`)
+
+// WithMathAnnotations instructs the converter to write the annotation of MathML
+// formulas (TeX preferably) instead of the linearized formula when there is one
+// Example: <semantics>...<annotation encoding="application/x-tex">\sqrt{2}</annotation></semantics> => \sqrt{2}
+func WithMathAnnotations() Option {
+	return func(o *options) {
+		o.mathAnnotations = true
+	}
+}
+
+// mathNode is a MathML element or text
+type mathNode struct {
+	name     string // empty for text
+	attrs    map[string]string
+	text     string
+	children []*mathNode
+}
+
+// mathElement writes the MathML formula starting the rest of the html as linear text
+// and hides the markup, e.g. x = (−b ± √(b² − 4ac)) / 2a
+func (c *converter) mathElement(e *element, rest string) {
+	if !c.isOpen(e) {
+		return
+	}
+	if c.hidden() {
+		// e.g. nested in a formula which has been written already
+		c.hideElement(e)
+		return
+	}
+	if end := indexMatchingEndTag(rest, "math"); end >= 0 {
+		rest = rest[:end]
+	}
+
+	root := &mathNode{name: "math", attrs: map[string]string{}}
+	root.children = parseMath(rest)
+
+	text := ""
+	if c.opts.mathAnnotations {
+		text = mathAnnotation(root)
+	}
+	if text == "" {
+		text = linearizeMath(root)
+	}
+	if text == "" {
+		text = strings.Join(strings.Fields(e.attr("alttext")), " ")
+	}
+
+	if e.attr("display") == "block" {
+		c.blockBreak(1)
+		e.onClose = append(e.onClose, func() {
+			c.blockBreak(1)
+		})
+	}
+	c.writeText(text)
+	c.hideElement(e)
+}
+
+// parseMath parses MathML markup into a tree, missing end tags are implied
+func parseMath(html string) []*mathNode {
+	root := &mathNode{}
+	stack := []*mathNode{root}
+	for _, m := range mathTokenRE.FindAllStringSubmatch(html, -1) {
+		top := stack[len(stack)-1]
+		switch {
+		case strings.HasPrefix(m[0], "<!--"):
+			continue
+		case m[2] == "":
+			// text
+			top.children = append(top.children, &mathNode{text: HTMLEntitiesToText(m[0])})
+		case m[1] == "/":
+			name := strings.ToLower(m[2])
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].name == name {
+					stack = stack[:i]
+					break
+				}
+			}
+		default:
+			n := &mathNode{name: strings.ToLower(m[2]), attrs: parseAttrs(m[2] + m[3])}
+			top.children = append(top.children, n)
+			if !strings.HasSuffix(m[3], "/") {
+				stack = append(stack, n)
+			}
+		}
+	}
+	return root.children
+}
+
+// elements returns child elements of the node, skipping text between them
+func (n *mathNode) elements() []*mathNode {
+	var elems []*mathNode
+	for _, child := range n.children {
+		if child.name != "" {
+			elems = append(elems, child)
+		}
+	}
+	return elems
+}
+
+// textContent returns the text of the node and its descendants with collapsed whitespace
+func (n *mathNode) textContent() string {
+	var sb strings.Builder
+	var walk func(n *mathNode)
+	walk = func(n *mathNode) {
+		sb.WriteString(n.text)
+		for _, child := range n.children {
+			walk(child)
+		}
+	}
+	walk(n)
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// mathAnnotation returns the text of the TeX annotation of the formula,
+// or of the first textual annotation if there is no TeX one
+func mathAnnotation(n *mathNode) string {
+	var first, tex string
+	var walk func(n *mathNode)
+	walk = func(n *mathNode) {
+		if n.name == "annotation" {
+			text := strings.TrimSpace(n.textContent())
+			if strings.Contains(strings.ToLower(n.attrs["encoding"]), "tex") && tex == "" {
+				tex = text
+			} else if first == "" {
+				first = text
+			}
+			return
+		}
+		for _, child := range n.children {
+			walk(child)
+		}
+	}
+	walk(n)
+
+	if tex != "" {
+		return tex
+	}
+	return first
+}
+
+// linearizeMath returns the formula of the node as a single line of text
+func linearizeMath(n *mathNode) string {
+	elems := n.elements()
+	arg := func(i int) string {
+		if i < len(elems) {
+			return linearizeMath(elems[i])
+		}
+		return ""
+	}
+
+	switch n.name {
+	case "":
+		return ""
+	case "mi", "mn", "mtext", "ms":
+		return n.textContent()
+	case "mo":
+		return strings.TrimSpace(n.textContent())
+	case "mspace":
+		return " "
+	case "mphantom", "annotation", "annotation-xml", "none", "mprescripts":
+		return ""
+	case "semantics":
+		return arg(0)
+	case "msqrt":
+		return "√" + mathGroup(mathRow(elems))
+	case "mroot":
+		return mathScript(arg(1), superscripts, "^") + "√" + mathGroup(arg(0))
+	case "mfrac":
+		return mathGroup(arg(0)) + " / " + mathGroup(arg(1))
+	case "msup", "mover":
+		return mathGroup(arg(0)) + mathScript(arg(1), superscripts, "^")
+	case "msub", "munder":
+		return mathGroup(arg(0)) + mathScript(arg(1), subscripts, "_")
+	case "msubsup", "munderover":
+		return mathGroup(arg(0)) + mathScript(arg(1), subscripts, "_") + mathScript(arg(2), superscripts, "^")
+	case "mfenced":
+		open, close, seps := "(", ")", ","
+		if v, ok := n.attrs["open"]; ok {
+			open = v
+		}
+		if v, ok := n.attrs["close"]; ok {
+			close = v
+		}
+		if v, ok := n.attrs["separators"]; ok {
+			seps = strings.Join(strings.Fields(v), "")
+		}
+
+		var sb strings.Builder
+		sb.WriteString(open)
+		sepRunes := []rune(seps)
+		for i := range elems {
+			if i > 0 && len(sepRunes) > 0 {
+				sep := sepRunes[len(sepRunes)-1]
+				if i-1 < len(sepRunes) {
+					sep = sepRunes[i-1]
+				}
+				sb.WriteString(string(sep) + " ")
+			}
+			sb.WriteString(arg(i))
+		}
+		sb.WriteString(close)
+		return sb.String()
+	case "mtable":
+		rows := make([]string, len(elems))
+		for i, row := range elems {
+			var cells []string
+			for _, cell := range row.elements() {
+				cells = append(cells, linearizeMath(cell))
+			}
+			rows[i] = strings.Join(cells, ", ")
+		}
+		return "[" + strings.Join(rows, "; ") + "]"
+	}
+
+	// math, mrow, mstyle, mpadded, merror, mtd and unknown elements are rows
+	return mathRow(elems)
+}
+
+// mathRow concatenates the nodes, infix operators are surrounded by spaces
+func mathRow(nodes []*mathNode) string {
+	var sb strings.Builder
+	prevOperator := true // an operator at the start of the row is a prefix
+	for i, n := range nodes {
+		text := linearizeMath(n)
+		if n.name != "mo" {
+			sb.WriteString(text)
+			prevOperator = false
+			continue
+		}
+
+		form := n.attrs["form"]
+		switch {
+		case text == "⁡": // function application
+			if i+1 < len(nodes) && linearizeMath(nodes[i+1]) != "(" {
+				sb.WriteString(" ")
+			}
+		case text == "" || text == "⁢" || text == "⁣" || text == "⁤":
+			// invisible times, separator and plus
+		case strings.Contains("([{⟨⌈⌊|‖", text) && form != "postfix":
+			sb.WriteString(text)
+			prevOperator = true
+			continue
+		case strings.Contains(")]}⟩⌉⌋!′″%.", text):
+			sb.WriteString(text)
+		case text == "," || text == ";":
+			sb.WriteString(text + " ")
+		case form == "prefix" || form == "" && (prevOperator || i == len(nodes)-1):
+			sb.WriteString(text)
+			prevOperator = true
+			continue
+		case form == "postfix":
+			sb.WriteString(text)
+		default:
+			sb.WriteString(" " + text + " ")
+			prevOperator = true
+			continue
+		}
+		prevOperator = false
+	}
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// mathGroup wraps the formula into parentheses unless it is a single term
+func mathGroup(text string) string {
+	if !strings.Contains(text, " ") || isParenthesized(text) {
+		return text
+	}
+	return "(" + text + ")"
+}
+
+// isParenthesized reports whether the whole text is enclosed in a pair of parentheses
+func isParenthesized(text string) bool {
+	if !strings.HasPrefix(text, "(") || !strings.HasSuffix(text, ")") {
+		return false
+	}
+	depth := 0
+	for i, r := range text {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 && i < len(text)-1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// mathScript writes the script using Unicode superscripts or subscripts when possible
+func mathScript(text string, chars map[rune]rune, fallback string) string {
+	if text == "" {
+		return ""
+	}
+	script := toScript(strings.Replace(text, " ", "", -1), chars, fallback)
+	if strings.HasPrefix(script, fallback+"(") && utf8.RuneCountInString(text) == 1 {
+		return fallback + text
+	}
+	return script
+}
@@ -0,0 +1,90 @@
+package html2text
+
+import (
+	"regexp"
+	"strings"
+)
+
+var sourceTagRE = regexp.MustCompile(`(?i)<source(?:[\s/][^>]*)?>`)
+
+// mediaLabels are the kinds of media written by WithMediaPlaceholders
+var mediaLabels = map[string]string{
+	"video":  "Video",
+	"audio":  "Audio",
+	"img":    "Image",
+	"object": "Object",
+	"iframe": "Frame",
+	"embed":  "Embed",
+}
+
+// WithMediaPlaceholders instructs the converter to write placeholders for media elements
+// instead of their fallback content, e.g. "[Video: title]". The source URL or poster is
+// written after the placeholder if WithLinksInnerText is used as well.
+// Images are written only if they have an alt text.
+// Example: [Video: Product tour] <https://example.com/tour.mp4>
+func WithMediaPlaceholders() Option {
+	return func(o *options) {
+		o.mediaPlaceholders = true
+	}
+}
+
+// mediaElement writes the placeholder of the media element, rest is the html following the tag
+func (c *converter) mediaElement(e *element, rest string) {
+	label, ok := mediaLabels[e.tagName]
+	if !ok {
+		return
+	}
+
+	title := e.attr("title")
+	if title == "" {
+		title = e.attr("aria-label")
+	}
+	if e.tagName == "img" {
+		title = e.attr("alt")
+		if strings.TrimSpace(title) == "" {
+			// decorative images and tracking pixels
+			return
+		}
+	} else if title == "" && e.tagName == "object" {
+		title = e.attr("name")
+	}
+
+	title = strings.Join(strings.Fields(title), " ")
+	if title != "" {
+		c.writeText("[" + label + ": " + title + "]")
+	} else {
+		c.writeText("[" + label + "]")
+	}
+
+	if c.opts.linksInnerText {
+		if url := mediaURL(e, rest); url != "" && !badLinkHrefRE.MatchString(url) {
+			c.writeText(" <" + url + ">")
+		}
+	}
+
+	// the content of media elements is their fallback
+	c.hideElement(e)
+}
+
+// mediaURL returns the source of the media element, the first <source>
+// of video and audio or the poster of video if there is no source
+func mediaURL(e *element, rest string) string {
+	switch e.tagName {
+	case "object":
+		return strings.TrimSpace(e.attr("data"))
+	case "video", "audio":
+		if src := strings.TrimSpace(e.attr("src")); src != "" {
+			return src
+		}
+		if end := indexFold(rest, "</"+e.tagName); end >= 0 {
+			rest = rest[:end]
+		}
+		for _, tag := range sourceTagRE.FindAllString(rest, -1) {
+			if src := strings.TrimSpace(parseAttrs(tag[1 : len(tag)-1])["src"]); src != "" {
+				return src
+			}
+		}
+		return strings.TrimSpace(e.attr("poster"))
+	}
+	return strings.TrimSpace(e.attr("src"))
+}
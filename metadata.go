@@ -0,0 +1,119 @@
+package html2text
+
+import "strings"
+
+// Metadata describes the document, see HTML2TextWithMetadata
+type Metadata struct {
+	Title       string   // <title>
+	Description string   // <meta name="description">
+	Keywords    []string // <meta name="keywords"> split by commas
+	Author      string   // <meta name="author">
+	Lang        string   // lang attribute of <html>
+	Canonical   string   // <link rel="canonical">
+
+	// OpenGraph holds the values of <meta property="og:..."> in the order of appearance
+	// by the property without the "og:" prefix, e.g. all og:image of the document
+	OpenGraph map[string][]string
+	// Twitter holds the values of <meta name="twitter:..."> in the order of appearance
+	// by the name without the "twitter:" prefix
+	Twitter map[string][]string
+	// JSONLD holds the content of <script type="application/ld+json"> blocks
+	JSONLD []string
+}
+
+// HTML2TextWithMetadata converts html into a text form like HTML2TextWithOptions
+// and returns the metadata of the document collected during the conversion.
+// Only the first occurrence of the single value fields is used.
+func HTML2TextWithMetadata(html string, reqOpts ...Option) (string, Metadata) {
+	opts := newOptions()
+	for _, opt := range reqOpts {
+		opt(opts)
+	}
+
+	c := newConverter(opts)
+	c.meta = &Metadata{}
+	text := c.convert(html)
+	return text, *c.meta
+}
+
+// metadataElement collects metadata from the opened element, rest is the html following the tag
+func (c *converter) metadataElement(e *element, rest string) {
+	meta := c.meta
+
+	switch e.tagName {
+	case "html":
+		if meta.Lang == "" {
+			meta.Lang = strings.TrimSpace(e.attr("lang"))
+		}
+
+	case "title":
+		for _, open := range c.stack {
+			if open.tagName == "svg" {
+				// title of an image
+				return
+			}
+		}
+		if c.titleSeen {
+			return
+		}
+		c.titleSeen = true
+		if end := indexEndTag(rest, "title"); end >= 0 {
+			meta.Title = strings.Join(strings.Fields(HTMLEntitiesToText(rest[:end])), " ")
+		}
+
+	case "link":
+		for _, rel := range strings.Fields(strings.ToLower(e.attr("rel"))) {
+			if rel == "canonical" && meta.Canonical == "" {
+				meta.Canonical = strings.TrimSpace(e.attr("href"))
+			}
+		}
+
+	case "meta":
+		content := strings.TrimSpace(e.attr("content"))
+		name := strings.ToLower(strings.TrimSpace(e.attr("name")))
+		property := strings.ToLower(strings.TrimSpace(e.attr("property")))
+		if content == "" {
+			return
+		}
+
+		switch {
+		case name == "description" && meta.Description == "":
+			meta.Description = content
+		case name == "author" && meta.Author == "":
+			meta.Author = content
+		case name == "keywords" && meta.Keywords == nil:
+			for _, keyword := range strings.Split(content, ",") {
+				if keyword = strings.TrimSpace(keyword); keyword != "" {
+					meta.Keywords = append(meta.Keywords, keyword)
+				}
+			}
+		case strings.HasPrefix(property, "og:"):
+			meta.OpenGraph = addMetadataField(meta.OpenGraph, property[len("og:"):], content)
+		case strings.HasPrefix(name, "twitter:"):
+			meta.Twitter = addMetadataField(meta.Twitter, name[len("twitter:"):], content)
+		case strings.HasPrefix(property, "twitter:"):
+			// commonly used by mistake
+			meta.Twitter = addMetadataField(meta.Twitter, property[len("twitter:"):], content)
+		}
+
+	case "script":
+		if strings.ToLower(strings.TrimSpace(e.attr("type"))) != "application/ld+json" {
+			return
+		}
+		if end := indexEndTag(rest, "script"); end >= 0 {
+			rest = rest[:end]
+		}
+		if json := strings.TrimSpace(rest); json != "" {
+			meta.JSONLD = append(meta.JSONLD, json)
+		}
+	}
+}
+
+// addMetadataField adds the value to the values of the key
+func addMetadataField(fields map[string][]string, key, value string) map[string][]string {
+	if fields == nil {
+		fields = map[string][]string{}
+	}
+	fields[key] = append(fields[key], value)
+	return fields
+}
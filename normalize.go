@@ -0,0 +1,74 @@
+package html2text
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var anyLbrRE = regexp.MustCompile(`\r\n|\r|\n`)
+
+// WithLineNormalization instructs the converter to post-process the output: line breaks
+// (including those coming from entities) are converted to the configured ones, whitespace
+// at the end of lines and blank lines at the beginning and the end of the output
+// are removed and runs of more than maxBlankLines blank lines are collapsed.
+// Negative maxBlankLines keeps all blank lines between the text.
+func WithLineNormalization(maxBlankLines int) Option {
+	return func(o *options) {
+		o.normalizeLines = true
+		o.maxBlankLines = maxBlankLines
+	}
+}
+
+// normalizeLines implements WithLineNormalization, offsets into the text
+// are updated to point to the same text in the result
+func normalizeLines(text string, opts *options, offsets ...*int) string {
+	var sb strings.Builder
+	mapped := make([]int, len(offsets))
+	done := make([]bool, len(offsets))
+
+	blank, lineStart := 0, 0
+	bounds := append(anyLbrRE.FindAllStringIndex(text, -1), []int{len(text), len(text)})
+	for _, b := range bounds {
+		line := strings.TrimRightFunc(text[lineStart:b[0]], unicode.IsSpace)
+		origStart := lineStart
+		lineStart = b[1]
+		if line == "" {
+			blank++
+			continue
+		}
+
+		if sb.Len() > 0 {
+			if opts.maxBlankLines >= 0 && blank > opts.maxBlankLines {
+				blank = opts.maxBlankLines
+			}
+			for ; blank >= 0; blank-- {
+				sb.WriteString(opts.lbr)
+			}
+		}
+		blank = 0
+
+		for i, off := range offsets {
+			if done[i] || *off > origStart+len(line) {
+				continue
+			}
+			// offsets into removed whitespace move to the start of the line
+			mapped[i] = sb.Len()
+			if *off > origStart {
+				mapped[i] += *off - origStart
+			}
+			done[i] = true
+		}
+		sb.WriteString(line)
+	}
+
+	for i, off := range offsets {
+		if !done[i] {
+			mapped[i] = sb.Len()
+		}
+		if *off >= 0 {
+			*off = mapped[i]
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,90 @@
+package html2text
+
+import "strings"
+
+// quoteMarks are the primary and nested quotation marks (open, close, open, close)
+type quoteMarks [4]string
+
+// nnbsp is a narrow no-break space used inside French quotes
+const nnbsp = "\u202F"
+
+var asciiQuotes = quoteMarks{`"`, `"`, `'`, `'`}
+
+// quotesByLang maps lowercase language tags or their primary subtags to quotation marks
+var quotesByLang = map[string]quoteMarks{
+	"en":    {"“", "”", "‘", "’"},
+	"de":    {"„", "“", "‚", "‘"},
+	"de-ch": {"«", "»", "‹", "›"},
+	"fr":    {"«" + nnbsp, nnbsp + "»", "“", "”"},
+	"es":    {"«", "»", "“", "”"},
+	"it":    {"«", "»", "“", "”"},
+	"pt":    {"«", "»", "“", "”"},
+	"pt-br": {"“", "”", "‘", "’"},
+	"ru":    {"«", "»", "„", "“"},
+	"uk":    {"«", "»", "„", "“"},
+	"pl":    {"„", "”", "«", "»"},
+	"cs":    {"„", "“", "‚", "‘"},
+	"sk":    {"„", "“", "‚", "‘"},
+	"hu":    {"„", "”", "»", "«"},
+	"nl":    {"“", "”", "‘", "’"},
+	"da":    {"»", "«", "›", "‹"},
+	"nb":    {"«", "»", "‘", "’"},
+	"no":    {"«", "»", "‘", "’"},
+	"sv":    {"”", "”", "’", "’"},
+	"fi":    {"”", "”", "’", "’"},
+	"ja":    {"「", "」", "『", "』"},
+	"zh":    {"“", "”", "‘", "’"},
+	"zh-tw": {"「", "」", "『", "』"},
+	"ko":    {"“", "”", "‘", "’"},
+}
+
+// WithASCIIQuotes instructs the converter to wrap <q> into plain ASCII quotes
+// instead of typographic quotation marks of the element's language
+func WithASCIIQuotes() Option {
+	return func(o *options) {
+		o.asciiQuotes = true
+	}
+}
+
+// quotesForLang returns quotation marks for the language tag, English by default
+func quotesForLang(lang string) quoteMarks {
+	lang = strings.ToLower(strings.Replace(strings.TrimSpace(lang), "_", "-", -1))
+	if q, ok := quotesByLang[lang]; ok {
+		return q
+	}
+	if i := strings.IndexByte(lang, '-'); i > 0 {
+		if q, ok := quotesByLang[lang[:i]]; ok {
+			return q
+		}
+	}
+	return quotesByLang["en"]
+}
+
+// quoteElement wraps the text of <q> into quotation marks according to
+// the nearest lang attribute, nested quotes use the secondary marks
+func (c *converter) quoteElement(e *element) {
+	if !c.isOpen(e) {
+		return
+	}
+
+	marks := asciiQuotes
+	if !c.opts.asciiQuotes {
+		lang := c.closest("lang")
+		if lang == "" {
+			lang = c.closest("xml:lang")
+		}
+		marks = quotesForLang(lang)
+	}
+
+	nested := c.quoteDepth%2 == 1
+	c.quoteDepth++
+
+	e.onClose = append(e.onClose, func() {
+		c.quoteDepth--
+	})
+	if nested {
+		c.markElement(e, marks[2], marks[3])
+	} else {
+		c.markElement(e, marks[0], marks[1])
+	}
+}
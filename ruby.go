@@ -0,0 +1,75 @@
+package html2text
+
+// RubyMode specifies how ruby annotations (<ruby>, <rt>) are written
+type RubyMode int
+
+const (
+	// RubyParentheses writes annotations in parentheses after their base text (the default)
+	// Example: <ruby>漢字<rt>かんじ</rt></ruby> => 漢字(かんじ)
+	RubyParentheses RubyMode = iota
+	// RubyBase writes only the base text, e.g. 漢字
+	RubyBase
+	// RubyAnnotations writes only the annotations, e.g. かんじ
+	RubyAnnotations
+)
+
+// WithRubyMode instructs the converter how to write ruby annotations.
+// Fallback parentheses in <rp> are always dropped.
+func WithRubyMode(mode RubyMode) Option {
+	return func(o *options) {
+		o.rubyMode = mode
+	}
+}
+
+// rubyElement handles <ruby> and its <rt>, <rtc>, <rp> and <rb> children
+func (c *converter) rubyElement(e *element) {
+	switch e.tagName {
+	case "ruby":
+		if c.opts.rubyMode == RubyAnnotations {
+			c.hideElement(e)
+		}
+
+	case "rt", "rtc", "rp", "rb":
+		switch {
+		case e.tagName == "rp":
+			c.hideElement(e)
+		case e.tagName == "rb":
+			// base text is written as any other text
+		case c.opts.rubyMode == RubyBase:
+			c.hideElement(e)
+		case c.opts.rubyMode == RubyAnnotations:
+			c.unhideElement(e)
+		case e.tagName == "rt":
+			c.markElement(e, "(", ")")
+		}
+	}
+}
+
+// hideElement hides the content of the element
+func (c *converter) hideElement(e *element) {
+	if !c.isOpen(e) || e.hidden {
+		return
+	}
+	e.hidden = true
+	c.badTagStackDepth++
+	e.onClose = append(e.onClose, func() {
+		c.badTagStackDepth--
+	})
+}
+
+// unhideElement shows the content of the element if it is hidden
+// only by an open ancestor, e.g. <rt> of a <ruby> hidden by RubyAnnotations
+func (c *converter) unhideElement(e *element) {
+	if c.badTagStackDepth != 1 || !c.isOpen(e) {
+		return
+	}
+	for i := len(c.stack) - 2; i >= 0; i-- {
+		if c.stack[i].hidden {
+			c.badTagStackDepth--
+			e.onClose = append(e.onClose, func() {
+				c.badTagStackDepth++
+			})
+			return
+		}
+	}
+}
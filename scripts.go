@@ -0,0 +1,94 @@
+package html2text
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var smallCapsRE = regexp.MustCompile(`(?i)font-variant(?:-caps)?\s*:[^;]*small-caps`)
+
+var (
+	superscripts = scriptMap("0123456789+-−=()abcdefghijklmnoprstuvwxyz",
+		"⁰¹²³⁴⁵⁶⁷⁸⁹⁺⁻⁻⁼⁽⁾ᵃᵇᶜᵈᵉᶠᵍʰⁱʲᵏˡᵐⁿᵒᵖʳˢᵗᵘᵛʷˣʸᶻ")
+	subscripts = scriptMap("0123456789+-−=()aehijklmnoprstuvx",
+		"₀₁₂₃₄₅₆₇₈₉₊₋₋₌₍₎ₐₑₕᵢⱼₖₗₘₙₒₚᵣₛₜᵤᵥₓ")
+	// there is no small capital x
+	smallCapitals = scriptMap("abcdefghijklmnopqrstuvwyz", "ᴀʙᴄᴅᴇꜰɢʜɪᴊᴋʟᴍɴᴏᴘꞯʀꜱᴛᴜᴠᴡʏᴢ")
+)
+
+func scriptMap(from, to string) map[rune]rune {
+	m := map[rune]rune{}
+	toRunes := []rune(to)
+	for i, r := range []rune(from) {
+		m[r] = toRunes[i]
+	}
+	return m
+}
+
+// WithSuperSubscripts instructs the converter to write <sup> and <sub> using Unicode
+// superscript and subscript characters if all characters of the text have them,
+// or as ^(text) and _(text) otherwise. Text in font-variant small-caps is uppercased.
+// Lowercase letters of <small> are written as Unicode small capitals if all of them
+// have one, so fine print stays apart from the text, or as they are otherwise.
+// Example: x<sup>2</sup> H<sub>2</sub>O <small>fine print</small> => x² H₂O ꜰɪɴᴇ ᴘʀɪɴᴛ
+func WithSuperSubscripts() Option {
+	return func(o *options) {
+		o.superSubscripts = true
+	}
+}
+
+// toScript returns the text in superscript or subscript characters
+// or wrapped in the fallback notation
+func toScript(text string, chars map[rune]rune, fallback string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		sr, ok := chars[r]
+		if !ok {
+			return fallback + "(" + text + ")"
+		}
+		sb.WriteRune(sr)
+	}
+	return sb.String()
+}
+
+// toSmallCapitals returns the text with lowercase letters in small capitals,
+// or the text as it is if any of them has no small capital
+func toSmallCapitals(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		if sr, ok := smallCapitals[r]; ok {
+			sb.WriteRune(sr)
+		} else if unicode.IsLower(r) {
+			return text
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// scriptElement handles <sup>, <sub>, <small> and elements in small caps
+func (c *converter) scriptElement(e *element) {
+	var transform func(string) string
+	switch {
+	case e.tagName == "sup":
+		transform = func(text string) string {
+			return toScript(text, superscripts, "^")
+		}
+	case e.tagName == "sub":
+		transform = func(text string) string {
+			return toScript(text, subscripts, "_")
+		}
+	case smallCapsRE.MatchString(e.attr("style")):
+		transform = strings.ToUpper
+	case e.tagName == "small":
+		transform = toSmallCapitals
+	default:
+		return
+	}
+
+	if m := c.markElement(e, "", ""); m != nil {
+		m.transform = transform
+	}
+}
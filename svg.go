@@ -0,0 +1,32 @@
+package html2text
+
+import (
+	"regexp"
+	"strings"
+)
+
+var svgTitleRE = regexp.MustCompile(`(?i)<title(?:\s[^>]*)?>([^<]*)</title`)
+
+// svgElement reduces an inline <svg> image to its label taken from aria-label
+// or its <title>, images without a label are removed. rest is the html following the tag.
+func (c *converter) svgElement(e *element, rest string) {
+	if !c.isOpen(e) {
+		return
+	}
+
+	label := ""
+	if e.attr("aria-hidden") != "true" && !c.hidden() {
+		label = e.attr("aria-label")
+		if label == "" {
+			if end := indexMatchingEndTag(rest, "svg"); end >= 0 {
+				rest = rest[:end]
+			}
+			if m := svgTitleRE.FindStringSubmatch(rest); m != nil {
+				label = HTMLEntitiesToText(m[1])
+			}
+		}
+	}
+
+	c.writeText(strings.Join(strings.Fields(label), " "))
+	c.hideElement(e)
+}
@@ -0,0 +1,237 @@
+package html2text
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var paragraphSepRE = regexp.MustCompile(`\n(?:[ \t]*\n)+`)
+var autoLinkRE = regexp.MustCompile(`(?i)\b(?:https?|ftp)://[^\s<>"]+`)
+
+var (
+	entityNamesOnce sync.Once
+	entityNameOf    map[rune]string
+)
+
+// html4Entities are the entity names defined by HTML 4, they are the conventional
+// names of characters having several, e.g. &uml; rather than &die; or &Dot;
+const html4Entities = "" +
+	"nbsp iexcl cent pound curren yen brvbar sect uml copy ordf laquo not shy reg macr deg plusmn " +
+	"sup2 sup3 acute micro para middot cedil sup1 ordm raquo frac14 frac12 frac34 iquest " +
+	"Agrave Aacute Acirc Atilde Auml Aring AElig Ccedil Egrave Eacute Ecirc Euml Igrave Iacute Icirc Iuml " +
+	"ETH Ntilde Ograve Oacute Ocirc Otilde Ouml times Oslash Ugrave Uacute Ucirc Uuml Yacute THORN szlig " +
+	"agrave aacute acirc atilde auml aring aelig ccedil egrave eacute ecirc euml igrave iacute icirc iuml " +
+	"eth ntilde ograve oacute ocirc otilde ouml divide oslash ugrave uacute ucirc uuml yacute thorn yuml " +
+	"fnof Alpha Beta Gamma Delta Epsilon Zeta Eta Theta Iota Kappa Lambda Mu Nu Xi Omicron Pi Rho Sigma " +
+	"Tau Upsilon Phi Chi Psi Omega alpha beta gamma delta epsilon zeta eta theta iota kappa lambda mu nu " +
+	"xi omicron pi rho sigmaf sigma tau upsilon phi chi psi omega thetasym upsih piv bull hellip prime " +
+	"Prime oline frasl weierp image real trade alefsym larr uarr rarr darr harr crarr lArr uArr rArr dArr " +
+	"hArr forall part exist empty nabla isin notin ni prod sum minus lowast radic prop infin ang and or " +
+	"cap cup int there4 sim cong asymp ne equiv le ge sub sup nsub sube supe oplus otimes perp sdot " +
+	"lceil rceil lfloor rfloor lang rang loz spades clubs hearts diams quot amp lt gt OElig oelig " +
+	"Scaron scaron Yuml circ tilde ensp emsp thinsp zwnj zwj lrm rlm ndash mdash lsquo rsquo sbquo " +
+	"ldquo rdquo bdquo dagger Dagger permil lsaquo rsaquo euro"
+
+// reverseEntity returns the preferred entity name for r
+func reverseEntity(r rune) (string, bool) {
+	entityNamesOnce.Do(func() {
+		html4 := map[string]bool{}
+		for _, name := range strings.Fields(html4Entities) {
+			html4[name] = true
+		}
+
+		entityNameOf = make(map[rune]string)
+		for i := 0; i < entityCount; i++ {
+			runes := []rune(entityValue(i))
+			if len(runes) != 1 || runes[0] < 0x80 {
+				// only single characters outside of ASCII are worth a name
+				continue
+			}
+
+			name := entityName(i)
+			if prev, ok := entityNameOf[runes[0]]; !ok || preferEntityName(name, prev, html4) {
+				entityNameOf[runes[0]] = name
+			}
+		}
+	})
+
+	name, ok := entityNameOf[r]
+	return name, ok
+}
+
+// preferEntityName reports whether the entity name a should be used instead of b.
+// HTML 4 names win, then shorter names and the ones with fewer upper case letters
+// (&copy; over &COPY;).
+func preferEntityName(a, b string, html4 map[string]bool) bool {
+	if html4[a] != html4[b] {
+		return html4[a]
+	}
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+
+	upper := func(s string) (n int) {
+		for _, r := range s {
+			if unicode.IsUpper(r) {
+				n++
+			}
+		}
+		return
+	}
+	if ua, ub := upper(a), upper(b); ua != ub {
+		return ua < ub
+	}
+
+	return a < b
+}
+
+type textOptions struct {
+	namedEntities bool
+	autoLinks     bool
+}
+
+// TextOption is a functional option of TextToHTML and EscapeHTML
+type TextOption func(*textOptions)
+
+// WithNamedEntities instructs TextToHTML and EscapeHTML to encode non-ASCII characters
+// using named entities where one exists
+// Example: © 2017 => &copy; 2017
+func WithNamedEntities() TextOption {
+	return func(o *textOptions) {
+		o.namedEntities = true
+	}
+}
+
+// WithAutoLinks instructs TextToHTML to convert http, https and ftp URLs into links
+func WithAutoLinks() TextOption {
+	return func(o *textOptions) {
+		o.autoLinks = true
+	}
+}
+
+func writeEscapedRune(outBuf *bytes.Buffer, r rune, opts *textOptions) {
+	switch r {
+	case '&':
+		outBuf.WriteString("&amp;")
+	case '<':
+		outBuf.WriteString("&lt;")
+	case '>':
+		outBuf.WriteString("&gt;")
+	case '"':
+		outBuf.WriteString("&quot;")
+	case '\'':
+		if opts.namedEntities {
+			outBuf.WriteString("&apos;")
+		} else {
+			outBuf.WriteString("&#39;")
+		}
+	default:
+		if opts.namedEntities {
+			if name, ok := reverseEntity(r); ok {
+				outBuf.WriteString("&" + name + ";")
+				return
+			}
+		}
+		outBuf.WriteRune(r)
+	}
+}
+
+func escapeHTML(outBuf *bytes.Buffer, text string, opts *textOptions) {
+	for _, r := range text {
+		writeEscapedRune(outBuf, r, opts)
+	}
+}
+
+// EscapeHTML escapes special characters like "<" to become "&lt;" so that
+// the text can be safely placed inside of HTML elements and attribute values.
+// It escapes only five such characters: <, >, &, ' and " unless
+// WithNamedEntities option is used.
+func EscapeHTML(text string, reqOpts ...TextOption) string {
+	opts := &textOptions{}
+	for _, opt := range reqOpts {
+		opt(opts)
+	}
+
+	outBuf := bytes.NewBufferString("")
+	escapeHTML(outBuf, text, opts)
+	return outBuf.String()
+}
+
+// writeTextLine writes a part of an escaped line of text, keeping the whitespace
+// which would otherwise be collapsed by HTML2Text. Spaces at the start and
+// the end of the line are always kept.
+func writeTextLine(outBuf *bytes.Buffer, text string, lineStart, lineEnd bool, opts *textOptions) {
+	space := lineStart // line start behaves as if preceded by a space
+	for i, r := range text {
+		switch {
+		case r == '\t':
+			outBuf.WriteString("&#9;")
+		case r == ' ' && (space || lineEnd && i == len(text)-1):
+			outBuf.WriteString("&#32;")
+		default:
+			writeEscapedRune(outBuf, r, opts)
+		}
+		space = r == ' ' || r == '\t'
+	}
+}
+
+// writeTextWithLinks writes an escaped line of text converting URLs into links
+func writeTextWithLinks(outBuf *bytes.Buffer, line string, opts *textOptions) {
+	last := 0
+	for _, m := range autoLinkRE.FindAllStringIndex(line, -1) {
+		url := strings.TrimRight(line[m[0]:m[1]], `.,;:!?'`)
+		if strings.HasSuffix(url, ")") && !strings.Contains(url, "(") {
+			url = strings.TrimRight(url, ")")
+		}
+
+		writeTextLine(outBuf, line[last:m[0]], last == 0, false, opts)
+		outBuf.WriteString(`<a href="`)
+		escapeHTML(outBuf, url, opts)
+		outBuf.WriteString(`">`)
+		escapeHTML(outBuf, url, opts)
+		outBuf.WriteString(`</a>`)
+		last = m[0] + len(url)
+	}
+	writeTextLine(outBuf, line[last:], last == 0, true, opts)
+}
+
+// TextToHTML converts plain text into HTML, being the inverse of HTML2Text.
+// Text separated by blank lines is wrapped into <p> paragraphs and single
+// line breaks become <br>. Consecutive spaces and tabs are kept as entities,
+// so HTML2Text(TextToHTML(text)) returns the original text as long as
+// it uses the same line breaks and paragraphs are separated by a single blank line.
+func TextToHTML(text string, reqOpts ...TextOption) string {
+	opts := &textOptions{}
+	for _, opt := range reqOpts {
+		opt(opts)
+	}
+
+	text = strings.Replace(text, WIN_LBR, UNIX_LBR, -1)
+	text = strings.Replace(text, "\r", UNIX_LBR, -1)
+
+	outBuf := bytes.NewBufferString("")
+	for _, para := range paragraphSepRE.Split(text, -1) {
+		if para == "" {
+			continue
+		}
+
+		outBuf.WriteString("<p>")
+		for i, line := range strings.Split(para, UNIX_LBR) {
+			if i > 0 {
+				outBuf.WriteString("<br>")
+			}
+
+			if opts.autoLinks {
+				writeTextWithLinks(outBuf, line, opts)
+			} else {
+				writeTextLine(outBuf, line, true, true, opts)
+			}
+		}
+		outBuf.WriteString("</p>")
+	}
+
+	return outBuf.String()
+}
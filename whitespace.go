@@ -0,0 +1,97 @@
+package html2text
+
+import (
+	"unicode/utf8"
+)
+
+// WhitespacePolicy specifies how special whitespace characters are written,
+// no matter whether they are literal or encoded as entities
+type WhitespacePolicy struct {
+	// NBSPAsSpace writes no-break spaces (U+00A0, U+2007, U+202F) as regular spaces,
+	// otherwise they are kept
+	NBSPAsSpace bool
+	// SoftHyphenBreaks keeps soft hyphens (&shy;) and writes <wbr> as a zero width space,
+	// so they can be used as break opportunities when wrapping the text,
+	// otherwise both are dropped
+	SoftHyphenBreaks bool
+}
+
+// WithWhitespacePolicy instructs the converter to handle special whitespace according to the policy.
+// Typographic spaces (U+2000-U+200A) are collapsed as regular spaces and zero width
+// characters (U+200B, U+2060, U+FEFF) are removed, as well as zero width joiners
+// outside of emoji sequences. Zero width non-joiners are kept as they affect shaping of the text.
+func WithWhitespacePolicy(policy WhitespacePolicy) Option {
+	return func(o *options) {
+		o.whitespacePolicy = &policy
+	}
+}
+
+// isSpecialWhitespace reports whether r is handled by WhitespacePolicy
+func isSpecialWhitespace(r rune) bool {
+	switch r {
+	case 0xA0, 0x2007, 0x202F, 0xAD, 0x200B, 0x200D, 0x2060, 0xFEFF:
+		return true
+	}
+	return r >= 0x2000 && r <= 0x200A
+}
+
+// writeSpecialWhitespace writes r according to the whitespace policy,
+// next is the input following r
+func (c *converter) writeSpecialWhitespace(r rune, next string) {
+	policy := c.opts.whitespacePolicy
+
+	switch r {
+	case 0xA0, 0x2007, 0x202F:
+		if policy.NBSPAsSpace {
+			c.writeText(" ")
+		} else {
+			c.writeText(string(r))
+		}
+	case 0xAD:
+		if policy.SoftHyphenBreaks {
+			c.writeText(string(r))
+		}
+	case 0x200D:
+		// keep only in emoji ZWJ sequences like 👨‍👩‍👧
+		prev, _ := utf8.DecodeLastRune(c.outBuf.Bytes())
+		if c.pendingLbr == 0 && !c.pendingSpace && isEmojiRune(prev) && isEmojiRune(nextRune(next)) {
+			c.writeText(string(r))
+		}
+	case 0x200B, 0x2060, 0xFEFF:
+		// zero width, dropped
+	default:
+		c.writeSpace()
+	}
+}
+
+// writeEntity writes the decoded entity, next is the input following the entity
+func (c *converter) writeEntity(ent, next string) {
+	if c.opts.whitespacePolicy != nil {
+		if r, size := utf8.DecodeRuneInString(ent); size == len(ent) && isSpecialWhitespace(r) {
+			c.writeSpecialWhitespace(r, next)
+			return
+		}
+	}
+	c.writeText(ent)
+}
+
+// nextRune returns the first character of the input, decoding an entity if there is one
+func nextRune(next string) rune {
+	if len(next) > 1 && next[0] == '&' {
+		if entName, isEnt := scanHTMLEntity(next[1:]); isEnt {
+			if ent, isEnt := parseHTMLEntity(entName); isEnt {
+				next = ent
+			}
+		}
+	}
+
+	r, _ := utf8.DecodeRuneInString(next)
+	return r
+}
+
+// isEmojiRune reports whether r can be a part of an emoji ZWJ sequence
+func isEmojiRune(r rune) bool {
+	return r >= 0x1F000 && r <= 0x1FAFF || // pictographs, emoticons, skin tone modifiers
+		r >= 0x2600 && r <= 0x27BF || // miscellaneous symbols and dingbats like ♀ ♂ ❤
+		r == 0xFE0F // emoji presentation selector
+}